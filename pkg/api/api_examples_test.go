@@ -46,6 +46,7 @@ func Example_usage() {
 
 		backend.Name = "backend-01"
 		backend.Mode = lbaasv1.HTTP
+		backend.LoadBalancer = lbaasv1.LoadBalancer{Identifier: "bogus identifier 2"}
 		// [...]
 
 		if err := apiClient.Create(context.TODO(), &backend); err != nil {
@@ -70,8 +71,9 @@ func ExampleAPI_create() {
 	apiClient := newExampleAPI()
 
 	backend := lbaasv1.Backend{
-		Name: "backend-01",
-		Mode: lbaasv1.HTTP,
+		Name:         "backend-01",
+		Mode:         lbaasv1.HTTP,
+		LoadBalancer: lbaasv1.LoadBalancer{Identifier: "bogus identifier 2"},
 		// [...]
 	}
 
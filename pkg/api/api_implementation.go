@@ -12,9 +12,11 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.anx.io/go-anxcloud/pkg/api/types"
 	corev1helper "go.anx.io/go-anxcloud/pkg/apis/core/v1/helper"
@@ -39,6 +41,15 @@ type defaultAPI struct {
 
 	clientOptions  []client.Option
 	requestOptions []types.Option
+
+	// tracer is set when tracing is enabled with [WithTracing].
+	tracer trace.Tracer
+
+	// metricReceiver is set when operation metrics are enabled with [WithMetricReceiver].
+	metricReceiver client.MetricReceiver
+
+	// interceptors configured with [WithInterceptors], called in order for every operation.
+	interceptors []Interceptor
 }
 
 // Logger returns the logger for the given API in the following order:
@@ -113,13 +124,33 @@ func NewAPI(opts ...NewAPIOption) (API, error) {
 		}
 	}
 
+	// environments configured in the client profile are defaults, request options given explicitly are applied after them
+	if c, ok := api.client.(interface{ Environments() map[string]string }); ok {
+		environments := c.Environments()
+
+		groups := make([]string, 0, len(environments))
+		for group := range environments {
+			groups = append(groups, group)
+		}
+		sort.Strings(groups)
+
+		profileOptions := make([]types.Option, 0, len(groups))
+		for _, group := range groups {
+			profileOptions = append(profileOptions, EnvironmentOption(group, environments[group], false))
+		}
+
+		api.requestOptions = append(profileOptions, api.requestOptions...)
+	}
+
 	return api, nil
 }
 
 // Get the identified object from the engine.
-func (a defaultAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+func (a defaultAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) (err error) {
+	ctx, done := a.instrumentOperation(ctx, types.OperationGet, o)
+	defer func() { done(err) }()
+
 	options := types.GetOptions{}
-	var err error
 	for _, opt := range resolveRequestOptions(a.requestOptions, opts) {
 		err = errors.Join(err, opt.ApplyToGet(&options))
 	}
@@ -127,13 +158,17 @@ func (a defaultAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...t
 		return fmt.Errorf("apply request options: %w", err)
 	}
 
-	return a.do(ctx, o, o, &options, types.OperationGet)
+	return a.intercept(ctx, types.OperationGet, o, &options, func(ctx context.Context) error {
+		return a.do(ctx, o, o, &options, types.OperationGet)
+	})
 }
 
 // Create the given object on the engine.
-func (a defaultAPI) Create(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+func (a defaultAPI) Create(ctx context.Context, o types.Object, opts ...types.CreateOption) (err error) {
+	ctx, done := a.instrumentOperation(ctx, types.OperationCreate, o)
+	defer func() { done(err) }()
+
 	options := types.CreateOptions{}
-	var err error
 	for _, opt := range resolveRequestOptions(a.requestOptions, opts) {
 		err = errors.Join(err, opt.ApplyToCreate(&options))
 	}
@@ -141,11 +176,17 @@ func (a defaultAPI) Create(ctx context.Context, o types.Object, opts ...types.Cr
 		return fmt.Errorf("apply request options: %w", err)
 	}
 
-	if err := a.do(ctx, o, o, &options, types.OperationCreate); err != nil {
-		return fmt.Errorf("API request failed: %w", err)
-	}
+	return a.intercept(ctx, types.OperationCreate, o, &options, func(ctx context.Context) error {
+		if err := validateObject(ctx, o, types.OperationCreate); err != nil {
+			return err
+		}
+
+		if err := a.do(ctx, o, o, &options, types.OperationCreate); err != nil {
+			return fmt.Errorf("API request failed: %w", err)
+		}
 
-	return a.handlePostCreateOptions(ctx, o, options)
+		return a.handlePostCreateOptions(ctx, o, options)
+	})
 }
 
 // handlePostCreateOptions executes configured Create options
@@ -161,9 +202,11 @@ func (a defaultAPI) handlePostCreateOptions(ctx context.Context, o types.Identif
 }
 
 // Update the object on the engine.
-func (a defaultAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...types.UpdateOption) error {
+func (a defaultAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...types.UpdateOption) (err error) {
+	ctx, done := a.instrumentOperation(ctx, types.OperationUpdate, o)
+	defer func() { done(err) }()
+
 	options := types.UpdateOptions{}
-	var err error
 	for _, opt := range resolveRequestOptions(a.requestOptions, opts) {
 		err = errors.Join(err, opt.ApplyToUpdate(&options))
 	}
@@ -171,13 +214,46 @@ func (a defaultAPI) Update(ctx context.Context, o types.IdentifiedObject, opts .
 		return fmt.Errorf("apply request options: %w", err)
 	}
 
-	return a.do(ctx, o, o, &options, types.OperationUpdate)
+	return a.intercept(ctx, types.OperationUpdate, o, &options, func(ctx context.Context) error {
+		if err := validateObject(ctx, o, types.OperationUpdate); err != nil {
+			return err
+		}
+
+		if options.Original != nil {
+			if err := a.partialUpdate(ctx, o, options); err != nil {
+				return err
+			}
+		} else if err := a.do(ctx, o, o, &options, types.OperationUpdate); err != nil {
+			return err
+		}
+
+		return a.handlePostUpdateOptions(ctx, o, options)
+	})
+}
+
+// handlePostUpdateOptions executes configured Update options
+// which should be handled after the object was successfully updated
+func (a defaultAPI) handlePostUpdateOptions(ctx context.Context, o types.IdentifiedObject, options types.UpdateOptions) error {
+	if options.ManagedTags != nil {
+		syncer, ok := corev1helper.TaggerImplementation.(corev1helper.TagSyncer)
+		if !ok {
+			return newErrTaggingFailed(errors.New("syncing tags is not supported by the tagger"))
+		}
+
+		if err := syncer.SyncTags(ctx, a, o, options.ManagedTags...); err != nil {
+			return newErrTaggingFailed(err)
+		}
+	}
+
+	return nil
 }
 
 // Destroy the identified object.
-func (a defaultAPI) Destroy(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+func (a defaultAPI) Destroy(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) (err error) {
+	ctx, done := a.instrumentOperation(ctx, types.OperationDestroy, o)
+	defer func() { done(err) }()
+
 	options := types.DestroyOptions{}
-	var err error
 	for _, opt := range resolveRequestOptions(a.requestOptions, opts) {
 		err = errors.Join(err, opt.ApplyToDestroy(&options))
 	}
@@ -185,13 +261,17 @@ func (a defaultAPI) Destroy(ctx context.Context, o types.IdentifiedObject, opts
 		return fmt.Errorf("apply request options: %w", err)
 	}
 
-	return a.do(ctx, o, o, &options, types.OperationDestroy)
+	return a.intercept(ctx, types.OperationDestroy, o, &options, func(ctx context.Context) error {
+		return a.do(ctx, o, o, &options, types.OperationDestroy)
+	})
 }
 
 // List objects matching the info given in the object.
-func (a defaultAPI) List(ctx context.Context, o types.FilterObject, opts ...types.ListOption) error {
+func (a defaultAPI) List(ctx context.Context, o types.FilterObject, opts ...types.ListOption) (err error) {
+	ctx, done := a.instrumentOperation(ctx, types.OperationList, o)
+	defer func() { done(err) }()
+
 	options := types.ListOptions{}
-	var err error
 	for _, opt := range resolveRequestOptions(a.requestOptions, opts) {
 		err = errors.Join(err, opt.ApplyToList(&options))
 	}
@@ -199,7 +279,13 @@ func (a defaultAPI) List(ctx context.Context, o types.FilterObject, opts ...type
 		return fmt.Errorf("apply request options: %w", err)
 	}
 
-	ctx, err = a.contextPrepare(ctx, o, types.OperationList, &options)
+	return a.intercept(ctx, types.OperationList, o, &options, func(ctx context.Context) error {
+		return a.list(ctx, o, options)
+	})
+}
+
+func (a defaultAPI) list(ctx context.Context, o types.FilterObject, options types.ListOptions) error {
+	ctx, err := a.contextPrepare(ctx, o, types.OperationList, &options)
 
 	if err != nil {
 		return err
@@ -402,28 +488,19 @@ func (a defaultAPI) makeRequest(ctx context.Context, obj types.Object, body inte
 		return nil, ErrOperationNotSupported
 	}
 
+	if partialBody, ok := body.(partialUpdateBody); ok && op == types.OperationUpdate {
+		method = partialBody.method
+	}
+
 	var bodyReader io.Reader = nil
 
 	if hasRequestBody {
-		buffer := bytes.Buffer{}
-
-		var requestBody interface{} = body
-
-		if filterRequestBody, ok := obj.(types.RequestBodyHook); ok {
-			rb, err := filterRequestBody.FilterAPIRequestBody(ctx)
-
-			if err != nil {
-				return nil, err
-			}
-
-			requestBody = rb
-		}
-
-		if err := json.NewEncoder(&buffer).Encode(requestBody); err != nil {
+		data, err := encodeRequestBody(ctx, obj, body)
+		if err != nil {
 			return nil, err
 		}
 
-		bodyReader = &buffer
+		bodyReader = bytes.NewReader(data)
 	}
 
 	request, err := http.NewRequestWithContext(ctx, method, fullURL.String(), bodyReader)
@@ -448,7 +525,34 @@ func (a defaultAPI) makeRequest(ctx context.Context, obj types.Object, body inte
 		}
 	}
 
-	return request, nil
+	return filterInterceptedRequest(ctx, request)
+}
+
+// encodeRequestBody encodes the given body, replaced by the result of the RequestBodyHook of the object if
+// implemented. Bodies of partial updates are returned as they are.
+func encodeRequestBody(ctx context.Context, obj types.Object, body interface{}) ([]byte, error) {
+	if partialBody, ok := body.(partialUpdateBody); ok {
+		return partialBody.data, nil
+	}
+
+	var requestBody interface{} = body
+
+	if filterRequestBody, ok := obj.(types.RequestBodyHook); ok {
+		rb, err := filterRequestBody.FilterAPIRequestBody(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		requestBody = rb
+	}
+
+	buffer := bytes.Buffer{}
+	if err := json.NewEncoder(&buffer).Encode(requestBody); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
 }
 
 func (a defaultAPI) doRequest(req *http.Request, obj types.Object, body interface{}) error {
@@ -469,7 +573,7 @@ func (a defaultAPI) doRequest(req *http.Request, obj types.Object, body interfac
 
 	defer response.Body.Close()
 
-	if err := ErrorFromResponse(req, response); err != nil {
+	if err := errorFromResponse(req, response, obj); err != nil {
 		return err
 	}
 
@@ -485,6 +589,31 @@ func (a defaultAPI) doRequest(req *http.Request, obj types.Object, body interfac
 	return nil
 }
 
+// instrumentOperation starts tracing and metrics for the given operation, the returned function has to be
+// called with the result of the operation.
+func (a defaultAPI) instrumentOperation(ctx context.Context, op types.Operation, o types.Object) (context.Context, func(error)) {
+	ctx, endSpan := a.startOperationSpan(ctx, op, o)
+	deliverMetrics := a.startOperationMetrics(op, o)
+
+	return ctx, func(err error) {
+		endSpan(err)
+		deliverMetrics(err)
+	}
+}
+
+// validateObject calls the Validate method of objects implementing [types.ValidationHook].
+func validateObject(ctx context.Context, o types.Object, op types.Operation) error {
+	if ctx == nil {
+		return ErrContextRequired
+	}
+
+	if v, ok := o.(types.ValidationHook); ok {
+		return v.Validate(ctx, op)
+	}
+
+	return nil
+}
+
 // contextPrepare attaches the given operation op, the options opts and the logger of the API to a newly constructed context and returns that.
 // If ctx is nil, [ErrContextRequired] is returned.
 func (a defaultAPI) contextPrepare(ctx context.Context, o types.Object, op types.Operation, opts types.Options) (context.Context, error) {
@@ -494,6 +623,7 @@ func (a defaultAPI) contextPrepare(ctx context.Context, o types.Object, op types
 
 	ctx = types.ContextWithOperation(ctx, op)
 	ctx = types.ContextWithOptions(ctx, opts)
+	ctx = client.ContextWithRedactedFields(ctx, client.SecretFields(o)...)
 
 	objectType := reflect.TypeOf(o)
 	for objectType.Kind() == reflect.Ptr {
@@ -106,6 +106,15 @@ type apiTestObject struct {
 
 var errAPITest = errors.New("we shall fail")
 
+type apiTestSecretObject struct {
+	apiTestObject
+	Credential string `json:"credential" anxcloud:"secret"`
+}
+
+func (o *apiTestSecretObject) FilterAPIRequestBody(ctx context.Context) (interface{}, error) {
+	return o, nil
+}
+
 func (o *apiTestObject) EndpointURL(ctx context.Context) (*url.URL, error) {
 	if o.Val == "failing" {
 		return nil, errAPITest
@@ -341,6 +350,37 @@ var _ = Describe("using an API object", func() {
 		Expect(log.String()).To(ContainSubstring("Hello from apiTestObject!"))
 	})
 
+	It("redacts fields tagged as secret in the logged request body", func(ctx context.Context) {
+		server.AppendHandlers(ghttp.RespondWithJSONEncoded(200, map[string]string{"value": "identifier"}))
+
+		log := strings.Builder{}
+
+		logger := funcr.New(
+			func(prefix, args string) {
+				_, _ = log.WriteString(prefix + "\t" + args + "\n")
+			},
+			funcr.Options{
+				Verbosity: 3,
+			})
+
+		api, err := NewAPI(
+			WithClientOptions(
+				client.BaseURL(server.URL()),
+				client.IgnoreMissingToken(),
+				client.Logger(logger),
+			),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		o := apiTestSecretObject{apiTestObject: apiTestObject{"identifier"}, Credential: "hunter2"}
+		err = api.Create(ctx, &o)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(log.String()).To(ContainSubstring("Sending request to Engine"))
+		Expect(log.String()).NotTo(ContainSubstring("hunter2"))
+	})
+
 	It("handles the Object returning an error on EndpointURL", func() {
 		api, err := NewAPI(
 			WithLogger(logger),
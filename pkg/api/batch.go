@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// DefaultBatchConcurrency is the number of operations running at the same time in batch operations, unless
+// configured otherwise in BatchOptions.
+const DefaultBatchConcurrency = 5
+
+// ErrBatchAborted is set as error of Objects in batch operations not processed because the batch was aborted,
+// either by an error of another Object with BatchOptions.StopOnError set or by the context being cancelled.
+var ErrBatchAborted = errors.New("batch operation aborted")
+
+// BatchOptions configures batch operations like CreateMany.
+type BatchOptions struct {
+	// Concurrency is the maximum number of operations running at the same time, defaulting to
+	// DefaultBatchConcurrency.
+	Concurrency int
+
+	// StopOnError makes the batch not start operations for further Objects after an operation failed, operations
+	// already running are not cancelled. By default, all Objects are processed regardless of errors.
+	StopOnError bool
+}
+
+// BatchResult is the result of the operation for a single Object in a batch operation, in the order the Objects
+// were given.
+type BatchResult[T types.Object] struct {
+	Object T
+
+	// Err is the error of the operation for the Object, matching ErrBatchAborted if it wasn't processed.
+	Err error
+}
+
+// BatchItemError is the error of the operation for a single Object in a batch operation.
+type BatchItemError struct {
+	// Index of the Object in the batch
+	Index  int
+	Object types.Object
+	Err    error
+}
+
+// Error returns the error message, including the index and identifier of the Object.
+func (e *BatchItemError) Error() string {
+	if identifier, err := types.GetObjectIdentifier(e.Object, false); err == nil && identifier != "" {
+		return fmt.Sprintf("object %d (%q): %v", e.Index, identifier, e.Err)
+	}
+
+	return fmt.Sprintf("object %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the operation.
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by batch operations when the operation failed for at least one Object. It wraps the
+// BatchItemError of every failed or unprocessed Object, making errors.Is and errors.As match them.
+type BatchError struct {
+	Operation types.Operation
+
+	// Total is the number of Objects in the batch
+	Total  int
+	Errors []*BatchItemError
+}
+
+// Error returns the error message, containing the errors of all failed Objects.
+func (e *BatchError) Error() string {
+	failed := make([]string, 0, len(e.Errors))
+	aborted := 0
+
+	for _, err := range e.Errors {
+		if errors.Is(err.Err, ErrBatchAborted) {
+			aborted++
+		} else {
+			failed = append(failed, err.Error())
+		}
+	}
+
+	msg := fmt.Sprintf("%v failed for %d of %d objects", e.Operation, len(failed), e.Total)
+	if aborted > 0 {
+		msg += fmt.Sprintf(", %d not processed", aborted)
+	}
+	if len(failed) > 0 {
+		msg += ": " + strings.Join(failed, "; ")
+	}
+
+	return msg
+}
+
+// Unwrap returns the BatchItemError of every failed or unprocessed Object.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// GetMany retrieves the Objects with the given identifiers, running Get operations concurrently. The returned
+// results contain the retrieved Objects in the order of the identifiers, the returned error is a *BatchError if
+// any Get failed.
+//
+//	results, err := api.GetMany[lbaasv1.Server](ctx, a, identifiers, api.BatchOptions{Concurrency: 10})
+func GetMany[T any, PT interface {
+	*T
+	types.IdentifiedObject
+}](ctx context.Context, a types.API, identifiers []string, batch BatchOptions, opts ...types.GetOption) ([]BatchResult[PT], error) {
+	objects := make([]PT, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		o := PT(new(T))
+		if err := setObjectIdentifier(o, identifier); err != nil {
+			return nil, err
+		}
+		objects = append(objects, o)
+	}
+
+	return runBatch(ctx, types.OperationGet, objects, batch, func(ctx context.Context, o PT) error {
+		return a.Get(ctx, o, opts...)
+	})
+}
+
+// CreateMany creates the given Objects, running Create operations concurrently. Options like AutoTag are applied
+// to every Object. The returned results contain the Objects in the given order, the returned error is a
+// *BatchError if any Create failed. Objects with ErrTaggingFailed as error were created, but not tagged.
+func CreateMany[T types.Object](ctx context.Context, a types.API, objects []T, batch BatchOptions, opts ...types.CreateOption) ([]BatchResult[T], error) {
+	return runBatch(ctx, types.OperationCreate, objects, batch, func(ctx context.Context, o T) error {
+		return a.Create(ctx, o, opts...)
+	})
+}
+
+// UpdateMany updates the given Objects, running Update operations concurrently. The returned results contain the
+// Objects in the given order, the returned error is a *BatchError if any Update failed.
+func UpdateMany[T types.IdentifiedObject](ctx context.Context, a types.API, objects []T, batch BatchOptions, opts ...types.UpdateOption) ([]BatchResult[T], error) {
+	return runBatch(ctx, types.OperationUpdate, objects, batch, func(ctx context.Context, o T) error {
+		return a.Update(ctx, o, opts...)
+	})
+}
+
+// DestroyMany destroys the given Objects, running Destroy operations concurrently. The returned results contain
+// the Objects in the given order, the returned error is a *BatchError if any Destroy failed.
+func DestroyMany[T types.IdentifiedObject](ctx context.Context, a types.API, objects []T, batch BatchOptions, opts ...types.DestroyOption) ([]BatchResult[T], error) {
+	return runBatch(ctx, types.OperationDestroy, objects, batch, func(ctx context.Context, o T) error {
+		return a.Destroy(ctx, o, opts...)
+	})
+}
+
+// runBatch calls op for all objects with at most batch.Concurrency calls running at the same time.
+func runBatch[T types.Object](ctx context.Context, operation types.Operation, objects []T, batch BatchOptions, op func(context.Context, T) error) ([]BatchResult[T], error) {
+	concurrency := batch.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([]BatchResult[T], len(objects))
+	for i, o := range objects {
+		results[i].Object = o
+	}
+
+	var wg sync.WaitGroup
+	var failed atomic.Bool
+	slots := make(chan struct{}, concurrency)
+
+	for i := range objects {
+		if ctx.Err() == nil {
+			select {
+			case <-ctx.Done():
+			case slots <- struct{}{}:
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			results[i].Err = fmt.Errorf("%w: %w", ErrBatchAborted, err)
+			continue
+		} else if batch.StopOnError && failed.Load() {
+			<-slots
+			results[i].Err = ErrBatchAborted
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := op(ctx, objects[i]); err != nil {
+				results[i].Err = err
+				failed.Store(true)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	batchErr := &BatchError{Operation: operation, Total: len(objects)}
+	for i, result := range results {
+		if result.Err != nil {
+			batchErr.Errors = append(batchErr.Errors, &BatchItemError{Index: i, Object: result.Object, Err: result.Err})
+		}
+	}
+
+	if len(batchErr.Errors) > 0 {
+		return results, batchErr
+	}
+
+	return results, nil
+}
+
+// setObjectIdentifier sets the string field tagged `anxcloud:"identifier"` of the given Object.
+func setObjectIdentifier(o types.Object, identifier string) error {
+	v := reflect.ValueOf(o).Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %T is not a pointer to a struct", types.ErrTypeNotSupported, o)
+	}
+
+	field, ok := types.IdentifierField(v)
+	if !ok {
+		return fmt.Errorf("%w: %T", types.ErrObjectWithoutIdentifier, o)
+	} else if field.Kind() != reflect.String {
+		return fmt.Errorf("%w: %T", types.ErrObjectIdentifierTypeNotSupported, o)
+	}
+
+	field.SetString(identifier)
+	return nil
+}
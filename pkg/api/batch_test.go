@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	corev1helper "go.anx.io/go-anxcloud/pkg/apis/core/v1/helper"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+)
+
+var errBatchTagging = errors.New("tagging failed")
+
+// batchTestTagger records the tags of every Object and fails for the Object named by fail.
+type batchTestTagger struct {
+	corev1helper.Tagger
+
+	mu   sync.Mutex
+	tags map[string][]string
+	fail string
+}
+
+func (t *batchTestTagger) Tag(ctx context.Context, _ types.API, o types.IdentifiedObject, tags ...string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	acl := o.(*lbaasv1.ACL)
+	if acl.Name == t.fail {
+		return errBatchTagging
+	}
+
+	t.tags[acl.Name] = tags
+	return nil
+}
+
+type batchTestObject struct {
+	Name string
+}
+
+func (o *batchTestObject) EndpointURL(context.Context) (*url.URL, error) {
+	return url.Parse("/batch-test")
+}
+
+func (o *batchTestObject) GetIdentifier(context.Context) (string, error) {
+	return o.Name, nil
+}
+
+type batchTestTaggedObject struct {
+	batchTestObject
+
+	Identifier string `anxcloud:"identifier,filterable"`
+}
+
+var _ = Describe("batch operations", func() {
+	var engine *testEngine
+	var a types.API
+
+	var running, maxRunning atomic.Int64
+	var delay time.Duration
+
+	BeforeEach(func() {
+		running.Store(0)
+		maxRunning.Store(0)
+		delay = 0
+
+		engine = startTestEngine(func(engine http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := running.Add(1)
+				defer running.Add(-1)
+
+				for m := maxRunning.Load(); current > m && !maxRunning.CompareAndSwap(m, current); m = maxRunning.Load() {
+				}
+
+				time.Sleep(delay)
+				engine.ServeHTTP(w, r)
+			})
+		})
+
+		a = engine.newAPI()
+	})
+
+	newACLs := func(names ...string) []*lbaasv1.ACL {
+		acls := make([]*lbaasv1.ACL, 0, len(names))
+		for _, name := range names {
+			acls = append(acls, &lbaasv1.ACL{Name: name, ParentType: "frontend"})
+		}
+
+		return acls
+	}
+
+	identifiers := func(acls []*lbaasv1.ACL) []string {
+		ids := make([]string, 0, len(acls))
+		for _, acl := range acls {
+			ids = append(ids, acl.Identifier)
+		}
+
+		return ids
+	}
+
+	It("creates, retrieves, updates and destroys all objects", func() {
+		acls := newACLs("acl-01", "acl-02", "acl-03", "acl-04")
+
+		results, err := CreateMany(context.TODO(), a, acls, BatchOptions{Concurrency: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(4))
+		Expect(results[2].Object).To(BeIdenticalTo(acls[2]))
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(HaveLen(4))
+
+		retrieved, err := GetMany[lbaasv1.ACL](context.TODO(), a, identifiers(acls), BatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retrieved[1].Object.Name).To(Equal("acl-02"))
+		Expect(retrieved[3].Object.Name).To(Equal("acl-04"))
+
+		for _, acl := range acls {
+			acl.Name += "-updated"
+		}
+
+		_, err = UpdateMany(context.TODO(), a, acls, BatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		retrieved, err = GetMany[lbaasv1.ACL](context.TODO(), a, identifiers(acls), BatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retrieved[0].Object.Name).To(Equal("acl-01-updated"))
+
+		_, err = DestroyMany(context.TODO(), a, acls, BatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(BeEmpty())
+	})
+
+	It("limits the number of concurrent operations", func() {
+		delay = 10 * time.Millisecond
+
+		_, err := CreateMany(context.TODO(), a, newACLs("1", "2", "3", "4", "5", "6", "7", "8"), BatchOptions{Concurrency: 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(maxRunning.Load()).To(BeNumerically("<=", 3))
+		Expect(maxRunning.Load()).To(BeNumerically(">", 1))
+	})
+
+	It("continues on errors and returns an aggregated error", func() {
+		acls := newACLs("acl-01", "acl-02")
+		_, err := CreateMany(context.TODO(), a, acls, BatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		ids := append(identifiers(acls), "missing-01", "missing-02")
+		results, err := GetMany[lbaasv1.ACL](context.TODO(), a, ids, BatchOptions{})
+		Expect(err).To(MatchError(ErrNotFound))
+		Expect(err).To(MatchError(ContainSubstring("Get failed for 2 of 4 objects")))
+
+		var batchErr *BatchError
+		Expect(errors.As(err, &batchErr)).To(BeTrue())
+		Expect(batchErr.Errors).To(HaveLen(2))
+		Expect(batchErr.Errors[0].Index).To(Equal(2))
+		Expect(batchErr.Errors[0].Error()).To(HavePrefix(`object 2 ("missing-01"): `))
+
+		var httpErr HTTPError
+		Expect(errors.As(err, &httpErr)).To(BeTrue())
+		Expect(httpErr.StatusCode()).To(Equal(http.StatusNotFound))
+
+		Expect(results[0].Err).NotTo(HaveOccurred())
+		Expect(results[0].Object.Name).To(Equal("acl-01"))
+		Expect(results[3].Err).To(MatchError(ErrNotFound))
+	})
+
+	It("stops on the first error when requested", func() {
+		ids := []string{"missing", "acl-02", "acl-03", "acl-04"}
+		results, err := GetMany[lbaasv1.ACL](context.TODO(), a, ids, BatchOptions{Concurrency: 1, StopOnError: true})
+		Expect(err).To(MatchError(ErrNotFound))
+		Expect(err).To(MatchError(ErrBatchAborted))
+		Expect(err).To(MatchError(ContainSubstring("Get failed for 1 of 4 objects, 3 not processed")))
+
+		Expect(results[0].Err).To(MatchError(ErrNotFound))
+		for _, result := range results[1:] {
+			Expect(result.Err).To(MatchError(ErrBatchAborted))
+		}
+	})
+
+	It("aborts when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		results, err := CreateMany(ctx, a, newACLs("acl-01", "acl-02"), BatchOptions{})
+		Expect(err).To(MatchError(ErrBatchAborted))
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(results[1].Err).To(MatchError(context.Canceled))
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(BeEmpty())
+	})
+
+	It("tags created objects with AutoTag", func() {
+		tagger := &batchTestTagger{tags: make(map[string][]string), fail: "acl-02"}
+
+		previous := corev1helper.TaggerImplementation
+		corev1helper.TaggerImplementation = tagger
+		DeferCleanup(func() { corev1helper.TaggerImplementation = previous })
+
+		results, err := CreateMany(context.TODO(), a, newACLs("acl-01", "acl-02", "acl-03"), BatchOptions{}, AutoTag("foo", "bar"))
+		Expect(err).To(MatchError(errBatchTagging))
+
+		var taggingErr *ErrTaggingFailed
+		Expect(errors.As(err, &taggingErr)).To(BeTrue())
+		Expect(results[1].Err).To(MatchError(errBatchTagging))
+		Expect(results[1].Object.Identifier).NotTo(BeEmpty())
+
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(HaveLen(3))
+		Expect(tagger.tags).To(Equal(map[string][]string{
+			"acl-01": {"foo", "bar"},
+			"acl-03": {"foo", "bar"},
+		}))
+	})
+
+	It("sets embedded identifier fields for GetMany", func() {
+		o := &apiTestSecretObject{}
+		Expect(setObjectIdentifier(o, "foo")).To(Succeed())
+		Expect(o.Val).To(Equal("foo"))
+	})
+
+	It("sets identifier fields tagged with further options for GetMany", func() {
+		o := &batchTestTaggedObject{}
+		Expect(setObjectIdentifier(o, "foo")).To(Succeed())
+		Expect(o.Identifier).To(Equal("foo"))
+	})
+
+	It("requires objects with an identifier field for GetMany", func() {
+		_, err := GetMany[batchTestObject](context.TODO(), a, []string{"foo"}, BatchOptions{})
+		Expect(err).To(MatchError(types.ErrObjectWithoutIdentifier))
+	})
+})
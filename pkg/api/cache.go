@@ -0,0 +1,574 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mitchellh/copystructure"
+
+	"go.anx.io/go-anxcloud/pkg/api/internal"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+const (
+	// DefaultCacheTTL is the time Objects and List pages are cached by WithCache, unless configured otherwise.
+	DefaultCacheTTL = time.Minute
+
+	// bypassCacheKey is the key of the request option set by BypassCache.
+	bypassCacheKey = "cache/bypass"
+
+	// cacheSweepInterval is the number of stored entries after which expired entries are removed.
+	cacheSweepInterval = 1000
+)
+
+// CacheOption configures the cache created with WithCache.
+type CacheOption func(*CachedAPI)
+
+// CacheTTL sets the time Objects and List pages are cached, defaulting to DefaultCacheTTL.
+func CacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedAPI) {
+		c.ttl = ttl
+	}
+}
+
+// CacheTypeTTL sets the time Objects and List pages of the type of the given Object are cached, overriding the
+// one set with CacheTTL. A TTL of zero disables caching for the type.
+func CacheTypeTTL(o types.Object, ttl time.Duration) CacheOption {
+	return func(c *CachedAPI) {
+		c.typeTTL[reflect.TypeOf(o)] = ttl
+	}
+}
+
+// BypassCache makes Get and List operations made through a CachedAPI skip the cache, always retrieving the data
+// from the engine. The retrieved data still replaces the cached entries.
+func BypassCache() types.AnyOption {
+	return func(o types.Options) error {
+		return o.Set(bypassCacheKey, true, true)
+	}
+}
+
+// CacheStats contains the number of Get and List pages served from the cache (Hits) and retrieved from the engine
+// (Misses). Operations bypassing the cache are not counted.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// CachedAPI is an API caching the results of Get operations and the pages retrieved by List operations, created
+// with WithCache.
+type CachedAPI struct {
+	api     types.API
+	ttl     time.Duration
+	typeTTL map[reflect.Type]time.Duration
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+	stored  uint
+
+	// generations counts the invalidations per Object type, values retrieved before an invalidation are not stored
+	generations map[reflect.Type]uint64
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type cacheKey struct {
+	objectType reflect.Type
+
+	// endpoint URL of the operation with the request options applied, differing for environments set with
+	// EnvironmentOption
+	endpoint string
+
+	// identifier of the Object for Get operations
+	identifier string
+
+	// filter Object encoded as JSON, page number and entries per page for List operations
+	list   bool
+	filter string
+	page   uint
+	limit  uint
+}
+
+type cacheEntry struct {
+	expires time.Time
+
+	// copy of the Object for Get operations, cachedPage for List operations
+	value interface{}
+}
+
+type cachedPage struct {
+	objects    interface{}
+	totalPages uint
+	totalItems uint
+}
+
+// WithCache returns an API caching the Objects retrieved with Get and the pages retrieved with List from the given
+// API. Cached entries of an Object type are invalidated on Create, Update and Destroy operations with an Object of
+// the same type made through the returned API, changes made in other ways are only visible after the cached
+// entries expired. Objects and pages are cached per endpoint URL, separating the environments configured with
+// EnvironmentOption.
+//
+// List operations are only cached when retrieving the Objects via ObjectChannel or Paged option, FullObjects are
+// retrieved with Get operations through the cache.
+func WithCache(a types.API, opts ...CacheOption) *CachedAPI {
+	c := &CachedAPI{
+		api:     a,
+		ttl:     DefaultCacheTTL,
+		typeTTL: make(map[reflect.Type]time.Duration),
+		entries: make(map[cacheKey]cacheEntry),
+
+		generations: make(map[reflect.Type]uint64),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Stats returns the number of cache hits and misses.
+func (c *CachedAPI) Stats() CacheStats {
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// Get the identified object from the cache or the engine.
+func (c *CachedAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+	options := types.GetOptions{}
+	for _, opt := range opts {
+		if err := opt.ApplyToGet(&options); err != nil {
+			return fmt.Errorf("apply request options: %w", err)
+		}
+	}
+
+	identifier, err := types.GetObjectIdentifier(o, true)
+	ttl := c.ttlFor(o)
+	if err != nil || ttl <= 0 {
+		return c.api.Get(ctx, o, opts...)
+	}
+
+	endpoint, err := endpointURL(ctx, o, types.OperationGet, &options)
+	if err != nil {
+		return c.api.Get(ctx, o, opts...)
+	}
+
+	key := cacheKey{objectType: reflect.TypeOf(o), endpoint: endpoint, identifier: identifier}
+	bypass := bypassCache(&options)
+
+	if !bypass {
+		if cached, ok := c.load(key); ok {
+			c.hits.Add(1)
+			return copyObject(cached.(types.Object), o)
+		}
+
+		c.misses.Add(1)
+	}
+
+	generation := c.generation(key.objectType)
+	if err := c.api.Get(ctx, o, opts...); err != nil {
+		return err
+	}
+
+	cloned, err := copystructure.Copy(o)
+	if err != nil {
+		return fmt.Errorf("copy object for cache: %w", err)
+	}
+
+	c.store(key, cloned, ttl, generation)
+	return nil
+}
+
+// Create the given object on the engine, invalidating the cached List pages of its type.
+func (c *CachedAPI) Create(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+	defer c.invalidate(o)
+	return c.api.Create(ctx, o, opts...)
+}
+
+// Update the object on the engine, invalidating the cached object and the cached List pages of its type.
+func (c *CachedAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...types.UpdateOption) error {
+	defer c.invalidate(o)
+	return c.api.Update(ctx, o, opts...)
+}
+
+// Destroy the identified object, invalidating the cached object and the cached List pages of its type.
+func (c *CachedAPI) Destroy(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+	defer c.invalidate(o)
+	return c.api.Destroy(ctx, o, opts...)
+}
+
+// List objects matching the info given in the object, serving pages from the cache if possible.
+func (c *CachedAPI) List(ctx context.Context, o types.FilterObject, opts ...types.ListOption) error {
+	options := types.ListOptions{}
+	for _, opt := range opts {
+		if err := opt.ApplyToList(&options); err != nil {
+			return fmt.Errorf("apply request options: %w", err)
+		}
+	}
+
+	ttl := c.ttlFor(o)
+	if ttl <= 0 || (!options.Paged && options.ObjectChannel == nil) {
+		return c.api.List(ctx, o, opts...)
+	}
+
+	if options.ObjectChannel != nil && options.PageInfo != nil {
+		return ErrCannotListChannelAndPaged
+	}
+
+	filter, err := json.Marshal(o)
+	if err != nil {
+		return c.api.List(ctx, o, opts...)
+	}
+
+	endpoint, err := endpointURL(ctx, o, types.OperationList, &options)
+	if err != nil {
+		return c.api.List(ctx, o, opts...)
+	}
+
+	page, limit := options.Page, options.EntriesPerPage
+	if !options.Paged {
+		page, limit = 1, ListChannelDefaultPageSize
+	} else if page == 0 {
+		page = 1
+	}
+
+	// the options for retrieving pages, Paged is added for every page and FullObjects are retrieved through the cache
+	pageOpts := make([]types.ListOption, 0, len(opts))
+	for _, opt := range opts {
+		switch opt.(type) {
+		case internal.PagedOption, internal.ObjectChannelOption, internal.FullObjectsOption:
+		default:
+			pageOpts = append(pageOpts, opt)
+		}
+	}
+
+	pi := &cachedPageInfo{
+		ctx:         ctx,
+		cache:       c,
+		filter:      o,
+		opts:        pageOpts,
+		key:         cacheKey{objectType: reflect.TypeOf(o), endpoint: endpoint, list: true, filter: string(filter), limit: limit},
+		ttl:         ttl,
+		bypass:      bypassCache(&options),
+		fullObjects: options.FullObjects,
+		currentPage: page - 1,
+	}
+
+	// retrieving the first page right away returns errors from List, like the API does
+	if pi.firstPage, err = pi.fetch(page); err != nil {
+		return err
+	}
+
+	if options.PageInfo != nil {
+		*options.PageInfo = pi
+	}
+
+	if options.ObjectChannel != nil {
+		*options.ObjectChannel = pi.objectChannel()
+	}
+
+	return nil
+}
+
+func (c *CachedAPI) ttlFor(o types.Object) time.Duration {
+	if ttl, ok := c.typeTTL[reflect.TypeOf(o)]; ok {
+		return ttl
+	}
+
+	return c.ttl
+}
+
+func (c *CachedAPI) load(key cacheKey) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// generation returns the number of invalidations of the given Object type, to be passed to store for values
+// retrieved afterwards.
+func (c *CachedAPI) generation(objectType reflect.Type) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[objectType]
+}
+
+// store caches the given value, unless its Object type was invalidated since the given generation was returned,
+// as the value might have been retrieved before the change causing the invalidation.
+func (c *CachedAPI) store(key cacheKey, value interface{}, ttl time.Duration, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[key.objectType] != generation {
+		return
+	}
+
+	now := time.Now()
+	c.entries[key] = cacheEntry{expires: now.Add(ttl), value: value}
+
+	if c.stored++; c.stored%cacheSweepInterval == 0 {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+}
+
+// invalidate removes the cached Object and all cached List pages of the type of the given Object.
+func (c *CachedAPI) invalidate(o types.Object) {
+	objectType := reflect.TypeOf(o)
+	identifier, _ := types.GetObjectIdentifier(o, false)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[objectType]++
+
+	for key := range c.entries {
+		if key.objectType == objectType && (key.list || key.identifier == identifier) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// endpointURL returns the URL of the given operation on the Object with the given request options applied.
+func endpointURL(ctx context.Context, o types.Object, op types.Operation, opts types.Options) (string, error) {
+	u, err := o.EndpointURL(types.ContextWithOptions(types.ContextWithOperation(ctx, op), opts))
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func bypassCache(opts types.Options) bool {
+	bypass, err := opts.Get(bypassCacheKey)
+	return err == nil && bypass == true
+}
+
+// copyObject copies the cached Object into the given one, decoding its JSON representation when their types differ.
+func copyObject(cached types.Object, o types.Object) error {
+	if reflect.TypeOf(cached) != reflect.TypeOf(o) {
+		data, err := json.Marshal(cached)
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(data, o)
+	}
+
+	cloned, err := copystructure.Copy(cached)
+	if err != nil {
+		return fmt.Errorf("copy cached object: %w", err)
+	}
+
+	reflect.ValueOf(o).Elem().Set(reflect.ValueOf(cloned).Elem())
+	return nil
+}
+
+// cachedPageInfo is the PageInfo of List operations made through a CachedAPI, retrieving pages from the cache or
+// with a List operation for the single page.
+type cachedPageInfo struct {
+	ctx    context.Context
+	cache  *CachedAPI
+	filter types.FilterObject
+	opts   []types.ListOption
+
+	key         cacheKey
+	ttl         time.Duration
+	bypass      bool
+	fullObjects bool
+
+	// firstPage is retrieved by List and returned by the first call to Next
+	firstPage *cachedPage
+
+	currentPage uint
+	totalPages  uint
+	totalItems  uint
+	err         error
+}
+
+// CurrentPage returns the page number the last Next call processed.
+func (p *cachedPageInfo) CurrentPage() uint {
+	return p.currentPage
+}
+
+// TotalPages returns the total number of pages. Note: not all APIs support this and will then return 0.
+func (p *cachedPageInfo) TotalPages() uint {
+	return p.totalPages
+}
+
+// TotalItems returns the total number of items. Note: not all APIs support this and will then return 0.
+func (p *cachedPageInfo) TotalItems() uint {
+	return p.totalItems
+}
+
+// ItemsPerPage returns the maximum number of entries per page, corresponding to the Limit parameter given
+// to the Paged attribute.
+func (p *cachedPageInfo) ItemsPerPage() uint {
+	return p.key.limit
+}
+
+// Next retrieves the next page of objects to process, returning true when it has received another page of
+// objects and false on completion or error.
+func (p *cachedPageInfo) Next(objects interface{}) bool {
+	if p.err != nil {
+		return false
+	}
+
+	val := reflect.ValueOf(objects)
+	rawMessageType := reflect.TypeOf(json.RawMessage{})
+	objectType := reflect.TypeOf(p.filter).Elem()
+
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Slice ||
+		(val.Elem().Type().Elem() != objectType && val.Elem().Type().Elem() != rawMessageType) {
+		p.err = fmt.Errorf("%w: the argument given to PageInfo.Next() must be a pointer to []%v or []json.RawMessage, you gave %v", ErrTypeNotSupported, objectType, val.Type())
+		return false
+	}
+
+	page := p.firstPage
+	p.firstPage = nil
+
+	// spare the request for a page known to be empty
+	if page == nil && p.totalPages > 0 && p.currentPage >= p.totalPages {
+		return false
+	}
+
+	if page == nil {
+		if page, p.err = p.fetch(p.currentPage + 1); p.err != nil {
+			return false
+		}
+	}
+
+	cloned, err := copystructure.Copy(page.objects)
+	if err != nil {
+		p.err = fmt.Errorf("copy cached page: %w", err)
+		return false
+	}
+
+	pageObjects := reflect.ValueOf(cloned)
+
+	if p.fullObjects {
+		for i := 0; i < pageObjects.Len(); i++ {
+			if err := p.cache.Get(p.ctx, pageObjects.Index(i).Addr().Interface().(types.IdentifiedObject)); err != nil {
+				p.err = err
+				return false
+			}
+		}
+	}
+
+	if val.Elem().Type().Elem() == rawMessageType {
+		data, err := json.Marshal(cloned)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		if err := json.Unmarshal(data, objects); err != nil {
+			p.err = err
+			return false
+		}
+	} else {
+		val.Elem().Set(pageObjects)
+	}
+
+	p.currentPage++
+	p.totalPages = page.totalPages
+	p.totalItems = page.totalItems
+
+	return pageObjects.Len() > 0
+}
+
+// Error returns the error preventing Next to continue.
+func (p *cachedPageInfo) Error() error {
+	return p.err
+}
+
+// ResetError clears any stored error to resume the iterator.
+func (p *cachedPageInfo) ResetError() {
+	p.err = nil
+}
+
+// fetch retrieves the given page from the cache or with a List operation for the single page.
+func (p *cachedPageInfo) fetch(page uint) (*cachedPage, error) {
+	key := p.key
+	key.page = page
+
+	if !p.bypass {
+		if cached, ok := p.cache.load(key); ok {
+			p.cache.hits.Add(1)
+			return cached.(*cachedPage), nil
+		}
+
+		p.cache.misses.Add(1)
+	}
+
+	generation := p.cache.generation(key.objectType)
+
+	var pi types.PageInfo
+	if err := p.cache.api.List(p.ctx, p.filter, append(p.opts, Paged(page, p.key.limit, &pi))...); err != nil {
+		return nil, err
+	}
+
+	objects := reflect.New(reflect.SliceOf(reflect.TypeOf(p.filter).Elem()))
+	pi.Next(objects.Interface())
+	if err := pi.Error(); err != nil {
+		return nil, err
+	}
+
+	result := &cachedPage{
+		objects:    objects.Elem().Interface(),
+		totalPages: pi.TotalPages(),
+		totalItems: pi.TotalItems(),
+	}
+
+	p.cache.store(key, result, p.ttl, generation)
+	return result, nil
+}
+
+// objectChannel returns a channel streaming the objects of all pages, closed after the last page, on errors or when
+// the context is cancelled.
+func (p *cachedPageInfo) objectChannel() types.ObjectChannel {
+	c := make(chan types.ObjectRetriever)
+
+	go func() {
+		defer close(c)
+
+		objects := reflect.New(reflect.SliceOf(reflect.TypeOf(p.filter).Elem()))
+		for p.Next(objects.Interface()) {
+			for i := 0; i < objects.Elem().Len(); i++ {
+				object := objects.Elem().Index(i).Addr().Interface().(types.Object)
+				retriever := func(out types.Object) error {
+					return copyObject(object, out)
+				}
+
+				select {
+				case <-p.ctx.Done():
+					return
+				case c <- retriever:
+				}
+			}
+		}
+	}()
+
+	return c
+}
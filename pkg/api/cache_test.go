@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+// environmentObject is an Object with its endpoint in the environment configured for the "cache-test" API group.
+type environmentObject struct {
+	Identifier  string `json:"identifier" anxcloud:"identifier"`
+	Environment string `json:"environment"`
+}
+
+func (o *environmentObject) EndpointURL(ctx context.Context) (*url.URL, error) {
+	return url.Parse("/api/" + GetEnvironmentPathSegment(ctx, "cache-test", "default") + "/v1/object.json")
+}
+
+func (o *environmentObject) GetIdentifier(context.Context) (string, error) {
+	return o.Identifier, nil
+}
+
+var _ = Describe("WithCache", func() {
+	var requests atomic.Int64
+	var cacheOptions []CacheOption
+	var cache *CachedAPI
+	var acl lbaasv1.ACL
+
+	// beforeResponse is called once for the next Get request, after the engine handled it but before the response
+	// is sent
+	var beforeResponse func()
+
+	BeforeEach(func() {
+		cacheOptions = nil
+		beforeResponse = nil
+		requests.Store(0)
+	})
+
+	JustBeforeEach(func() {
+		engine := startTestEngine(func(engine http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+
+				if r.Method != http.MethodGet || beforeResponse == nil {
+					engine.ServeHTTP(w, r)
+					return
+				}
+
+				recorder := httptest.NewRecorder()
+				engine.ServeHTTP(recorder, r)
+
+				callback := beforeResponse
+				beforeResponse = nil
+				callback()
+
+				w.Header().Set("Content-Type", recorder.Header().Get("Content-Type"))
+				w.WriteHeader(recorder.Code)
+				_, _ = w.Write(recorder.Body.Bytes())
+			})
+		})
+
+		cache = WithCache(engine.newAPI(), cacheOptions...)
+
+		for _, name := range []string{"acl-01", "acl-02", "acl-03"} {
+			acl = lbaasv1.ACL{Name: name, ParentType: "frontend"}
+			Expect(cache.Create(context.TODO(), &acl)).To(Succeed())
+		}
+
+		requests.Store(0)
+	})
+
+	get := func(opts ...types.GetOption) lbaasv1.ACL {
+		retrieved := lbaasv1.ACL{Identifier: acl.Identifier}
+		Expect(cache.Get(context.TODO(), &retrieved, opts...)).To(Succeed())
+		return retrieved
+	}
+
+	listNames := func(opts ...types.ListOption) []string {
+		var oc types.ObjectChannel
+		Expect(cache.List(context.TODO(), &lbaasv1.ACL{}, append(opts, ObjectChannel(&oc))...)).To(Succeed())
+
+		names := make([]string, 0, 3)
+		for retriever := range oc {
+			var a lbaasv1.ACL
+			Expect(retriever(&a)).To(Succeed())
+			names = append(names, a.Name)
+		}
+
+		return names
+	}
+
+	It("serves Get from the cache", func() {
+		Expect(get().Name).To(Equal("acl-03"))
+		Expect(get().Name).To(Equal("acl-03"))
+
+		Expect(requests.Load()).To(BeEquivalentTo(1))
+		Expect(cache.Stats()).To(Equal(CacheStats{Hits: 1, Misses: 1}))
+	})
+
+	It("returns copies of cached objects", func() {
+		retrieved := get()
+		retrieved.Name = "changed"
+
+		Expect(get().Name).To(Equal("acl-03"))
+	})
+
+	It("invalidates cached objects on Update and Destroy", func() {
+		get()
+
+		acl.Name = "acl-04"
+		Expect(cache.Update(context.TODO(), &acl)).To(Succeed())
+		Expect(get().Name).To(Equal("acl-04"))
+
+		Expect(cache.Destroy(context.TODO(), &acl)).To(Succeed())
+		Expect(cache.Get(context.TODO(), &lbaasv1.ACL{Identifier: acl.Identifier})).To(MatchError(ErrNotFound))
+	})
+
+	It("does not store objects retrieved before an invalidation", func() {
+		beforeResponse = func() {
+			updated := acl
+			updated.Name = "acl-04"
+			Expect(cache.Update(context.TODO(), &updated)).To(Succeed())
+		}
+
+		Expect(get().Name).To(Equal("acl-03"))
+		Expect(get().Name).To(Equal("acl-04"))
+		Expect(cache.Stats()).To(Equal(CacheStats{Hits: 0, Misses: 2}))
+	})
+
+	It("bypasses the cache when requested", func() {
+		get()
+		get(BypassCache())
+
+		Expect(requests.Load()).To(BeEquivalentTo(2))
+		Expect(cache.Stats()).To(Equal(CacheStats{Hits: 0, Misses: 1}))
+	})
+
+	It("serves List pages from the cache", func() {
+		Expect(listNames()).To(Equal([]string{"acl-01", "acl-02", "acl-03"}))
+		Expect(listNames()).To(Equal([]string{"acl-01", "acl-02", "acl-03"}))
+		Expect(requests.Load()).To(BeEquivalentTo(1))
+
+		var pi types.PageInfo
+		Expect(cache.List(context.TODO(), &lbaasv1.ACL{}, Paged(2, 2, &pi))).To(Succeed())
+
+		var page []lbaasv1.ACL
+		Expect(pi.Next(&page)).To(BeTrue())
+		Expect(page).To(HaveLen(1))
+		Expect(page[0].Name).To(Equal("acl-03"))
+		Expect(pi.CurrentPage()).To(BeEquivalentTo(2))
+		Expect(pi.TotalItems()).To(BeEquivalentTo(3))
+		Expect(pi.Next(&page)).To(BeFalse())
+		Expect(pi.Error()).NotTo(HaveOccurred())
+
+		Expect(cache.List(context.TODO(), &lbaasv1.ACL{}, Paged(2, 2, &pi))).To(Succeed())
+		Expect(pi.Next(&page)).To(BeTrue())
+		Expect(page[0].Name).To(Equal("acl-03"))
+
+		Expect(requests.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("invalidates cached List pages on Create", func() {
+		listNames()
+
+		Expect(cache.Create(context.TODO(), &lbaasv1.ACL{Name: "acl-04", ParentType: "frontend"})).To(Succeed())
+		Expect(listNames()).To(ContainElement("acl-04"))
+	})
+
+	It("retrieves FullObjects through the cache", func() {
+		get()
+		listNames(FullObjects(true))
+
+		Expect(requests.Load()).To(BeEquivalentTo(4))
+		Expect(cache.Stats()).To(Equal(CacheStats{Hits: 1, Misses: 4}))
+	})
+
+	Context("with a short TTL", func() {
+		BeforeEach(func() {
+			cacheOptions = []CacheOption{CacheTTL(10 * time.Millisecond)}
+		})
+
+		It("expires cached objects", func() {
+			get()
+			time.Sleep(20 * time.Millisecond)
+			get()
+
+			Expect(requests.Load()).To(BeEquivalentTo(2))
+		})
+	})
+
+	Context("with caching disabled for the type", func() {
+		BeforeEach(func() {
+			cacheOptions = []CacheOption{CacheTypeTTL(&lbaasv1.ACL{}, 0)}
+		})
+
+		It("does not cache", func() {
+			get()
+			get()
+			listNames()
+
+			// the API retrieves an empty page to find the end of the list
+			Expect(requests.Load()).To(BeEquivalentTo(4))
+			Expect(cache.Stats()).To(Equal(CacheStats{}))
+		})
+	})
+
+	It("caches objects per environment", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(environmentObject{
+				Identifier:  "object-01",
+				Environment: strings.Split(r.URL.Path, "/")[2],
+			})
+		}))
+		DeferCleanup(server.Close)
+
+		a, err := NewAPI(WithClientOptions(
+			client.BaseURL(server.URL),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		cache := WithCache(a)
+		requests.Store(0)
+
+		getEnvironment := func(opts ...types.GetOption) string {
+			o := environmentObject{Identifier: "object-01"}
+			Expect(cache.Get(context.TODO(), &o, opts...)).To(Succeed())
+			return o.Environment
+		}
+
+		Expect(getEnvironment()).To(Equal("default"))
+		Expect(getEnvironment(EnvironmentOption("cache-test", "staging", true))).To(Equal("staging"))
+		Expect(getEnvironment(EnvironmentOption("cache-test", "staging", true))).To(Equal("staging"))
+		Expect(getEnvironment()).To(Equal("default"))
+
+		Expect(requests.Load()).To(BeEquivalentTo(2))
+		Expect(cache.Stats()).To(Equal(CacheStats{Hits: 2, Misses: 2}))
+	})
+})
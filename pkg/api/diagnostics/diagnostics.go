@@ -0,0 +1,334 @@
+// Package diagnostics checks the connection to the Engine using the generic API client, usable for startup
+// health checks and readiness probes.
+//
+// Run sends an echo request, verifying the Engine is reachable, the TLS connection can be established and the
+// token is accepted, followed by a cheap authorized read (listing a single object) for every resource type
+// configured with WithPermissionCheck, verifying the token is allowed to access the API groups used:
+//
+//	report := diagnostics.Run(ctx, a,
+//		diagnostics.WithPermissionCheck("vlan/v1", &vlanv1.VLAN{}),
+//		diagnostics.WithPermissionCheck("core/v1", &corev1.Location{}),
+//	)
+//
+//	if err := report.Err(); err != nil {
+//		return fmt.Errorf("Engine not usable: %w", err)
+//	}
+package diagnostics
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/test/echo"
+)
+
+// CheckConnectivity is the name of the check sending an echo request to the Engine.
+const CheckConnectivity = "connectivity"
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	// StatusOK is set for checks that succeeded.
+	StatusOK Status = "ok"
+	// StatusFailed is set for checks that failed, the Failure of the CheckResult tells why.
+	StatusFailed Status = "failed"
+	// StatusSkipped is set for permission checks when the connectivity check failed in a way making them fail, too.
+	StatusSkipped Status = "skipped"
+)
+
+// Failure classifies the error of a failed check.
+type Failure string
+
+const (
+	// FailureConnectivity means the Engine could not be reached, e.g. because of DNS or connection errors.
+	FailureConnectivity Failure = "connectivity"
+	// FailureTLS means the TLS connection could not be established, e.g. because of an untrusted certificate.
+	FailureTLS Failure = "tls"
+	// FailureTimeout means the check did not complete in time.
+	FailureTimeout Failure = "timeout"
+	// FailureAuthentication means the Engine did not accept the token.
+	FailureAuthentication Failure = "authentication"
+	// FailurePermission means the token is not allowed to access the checked resource type.
+	FailurePermission Failure = "permission"
+	// FailureEngine means the Engine responded with an unexpected error or response.
+	FailureEngine Failure = "engine"
+)
+
+// CheckResult is the result of a single check.
+type CheckResult struct {
+	// Name of the check, CheckConnectivity or the name given to WithPermissionCheck.
+	Name string
+
+	Status Status
+
+	// Failure classifies Err, empty if the check did not fail.
+	Failure Failure
+
+	// Latency is the time it took to run the check.
+	Latency time.Duration
+
+	// Err is the error the check failed with or why it was skipped.
+	Err error
+}
+
+// MarshalJSON encodes the result with the latency as duration string and the error as its message.
+func (r CheckResult) MarshalJSON() ([]byte, error) {
+	result := struct {
+		Name    string  `json:"name"`
+		Status  Status  `json:"status"`
+		Failure Failure `json:"failure,omitempty"`
+		Latency string  `json:"latency"`
+		Error   string  `json:"error,omitempty"`
+	}{
+		Name:    r.Name,
+		Status:  r.Status,
+		Failure: r.Failure,
+		Latency: r.Latency.String(),
+	}
+
+	if r.Err != nil {
+		result.Error = r.Err.Error()
+	}
+
+	return json.Marshal(result)
+}
+
+// Report is the result of Run.
+type Report struct {
+	// StartedAt is the time Run was called.
+	StartedAt time.Time
+
+	// Duration is the time it took to run all checks.
+	Duration time.Duration
+
+	// Checks contains the result of the connectivity check followed by the results of the permission checks,
+	// in the order they were configured.
+	Checks []CheckResult
+}
+
+// Healthy returns true if all checks succeeded.
+func (r Report) Healthy() bool {
+	for _, check := range r.Checks {
+		if check.Status != StatusOK {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Err returns an error for every failed check joined with errors.Join, nil if all checks succeeded.
+func (r Report) Err() error {
+	var err error
+
+	for _, check := range r.Checks {
+		if check.Status == StatusFailed {
+			err = errors.Join(err, fmt.Errorf("%s check failed (%s): %w", check.Name, check.Failure, check.Err))
+		}
+	}
+
+	return err
+}
+
+// MarshalJSON encodes the report with the durations as duration strings and the result of Healthy.
+func (r Report) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Healthy   bool          `json:"healthy"`
+		StartedAt time.Time     `json:"started_at"`
+		Duration  string        `json:"duration"`
+		Checks    []CheckResult `json:"checks"`
+	}{
+		Healthy:   r.Healthy(),
+		StartedAt: r.StartedAt,
+		Duration:  r.Duration.String(),
+		Checks:    r.Checks,
+	})
+}
+
+// Option configures Run.
+type Option func(*options)
+
+type permissionCheck struct {
+	name   string
+	object types.FilterObject
+}
+
+type options struct {
+	timeout          time.Duration
+	permissionChecks []permissionCheck
+}
+
+// WithTimeout limits the time all checks together may take, checks not completed in time fail with
+// FailureTimeout. Without this option, only the deadline of the context given to Run applies.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithPermissionCheck adds a check listing a single object of the type of the given object, verifying the
+// token is allowed to read it. The object is used as filter for the List operation, as usual for the generic
+// API, and not modified. The name identifies the check in the Report, the API group is a good choice.
+func WithPermissionCheck(name string, o types.FilterObject) Option {
+	return func(opts *options) {
+		opts.permissionChecks = append(opts.permissionChecks, permissionCheck{name: name, object: o})
+	}
+}
+
+// Run checks the connection to the Engine with the given API client, see the package documentation for the
+// checks done. Permission checks are run concurrently after the connectivity check and are skipped when it
+// failed because the Engine could not be reached.
+func Run(ctx context.Context, a types.API, opts ...Option) Report {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	report := Report{
+		StartedAt: time.Now(),
+		Checks:    make([]CheckResult, 1+len(o.permissionChecks)),
+	}
+
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	connectivity := runCheck(CheckConnectivity, func() error {
+		return checkEcho(ctx, a)
+	})
+	report.Checks[0] = connectivity
+
+	unreachable := connectivity.Failure == FailureConnectivity ||
+		connectivity.Failure == FailureTLS ||
+		connectivity.Failure == FailureTimeout
+
+	var wg sync.WaitGroup
+	for i, pc := range o.permissionChecks {
+		if unreachable {
+			report.Checks[1+i] = CheckResult{
+				Name:   pc.name,
+				Status: StatusSkipped,
+				Err:    fmt.Errorf("skipped because the %s check failed", CheckConnectivity),
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, pc permissionCheck) {
+			defer wg.Done()
+
+			report.Checks[1+i] = runCheck(pc.name, func() error {
+				var pageInfo types.PageInfo
+				return a.List(ctx, pc.object, api.Paged(1, 1, &pageInfo))
+			})
+		}(i, pc)
+	}
+	wg.Wait()
+
+	report.Duration = time.Since(report.StartedAt)
+
+	return report
+}
+
+// Handler returns a http.Handler running the checks for every request, responding with the Report encoded as
+// JSON, with status 200 when all checks succeeded and 503 otherwise. It is usable as readiness probe.
+func Handler(a types.API, opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := Run(r.Context(), a, opts...)
+
+		status := http.StatusOK
+		if !report.Healthy() {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+func runCheck(name string, check func() error) CheckResult {
+	start := time.Now()
+	err := check()
+
+	result := CheckResult{
+		Name:    name,
+		Status:  StatusOK,
+		Latency: time.Since(start),
+	}
+
+	if err != nil {
+		result.Status = StatusFailed
+		result.Failure = classifyError(err)
+		result.Err = err
+	}
+
+	return result
+}
+
+func checkEcho(ctx context.Context, a types.API) error {
+	req := echoRequest{
+		Value: fmt.Sprintf("%v", rand.Int()), //nolint:gosec // No secure generator required.
+	}
+
+	if err := a.Create(ctx, &req); err != nil {
+		return err
+	}
+
+	if req.response != req.Value {
+		return fmt.Errorf("%w: sent %q, received %q", echo.ErrInvalidEchoResponse, req.Value, req.response)
+	}
+
+	return nil
+}
+
+// classifyError returns the Failure matching the given error.
+func classifyError(err error) Failure {
+	var (
+		httpError         api.HTTPError
+		verificationError *tls.CertificateVerificationError
+		recordHeaderError tls.RecordHeaderError
+		unknownAuthority  x509.UnknownAuthorityError
+		hostnameError     x509.HostnameError
+		invalidCert       x509.CertificateInvalidError
+		netError          net.Error
+	)
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return FailureTimeout
+	case errors.As(err, &verificationError),
+		errors.As(err, &recordHeaderError),
+		errors.As(err, &unknownAuthority),
+		errors.As(err, &hostnameError),
+		errors.As(err, &invalidCert):
+		return FailureTLS
+	case errors.As(err, &httpError):
+		switch httpError.StatusCode() {
+		case http.StatusUnauthorized:
+			return FailureAuthentication
+		case http.StatusForbidden:
+			return FailurePermission
+		default:
+			return FailureEngine
+		}
+	case errors.As(err, &netError):
+		return FailureConnectivity
+	default:
+		return FailureEngine
+	}
+}
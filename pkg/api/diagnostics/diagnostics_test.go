@@ -0,0 +1,214 @@
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/diagnostics"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+	"go.anx.io/go-anxcloud/pkg/test/echo"
+)
+
+func echoHandler(w http.ResponseWriter, r *http.Request) {
+	defer GinkgoRecover()
+
+	Expect(r.Method).To(Equal(http.MethodPut))
+
+	request := map[string]string{}
+	Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+
+	ghttp.RespondWithJSONEncoded(200, request["value"])(w, r)
+}
+
+func vlanListHandler(w http.ResponseWriter, r *http.Request) {
+	data := []map[string]string{}
+	if r.URL.Query().Get("page") == "1" {
+		data = append(data, map[string]string{"identifier": "foo"})
+	}
+
+	ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+		"page":        1,
+		"total_pages": 1,
+		"total_items": 1,
+		"limit":       1,
+		"data":        data,
+	})(w, r)
+}
+
+var _ = Describe("Run", func() {
+	var (
+		srv *ghttp.Server
+		a   types.API
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reports healthy when all checks succeed", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, echoHandler)
+		srv.RouteToHandler("GET", "/api/vlan/v1/vlan.json/filtered", vlanListHandler)
+
+		report := diagnostics.Run(context.TODO(), a, diagnostics.WithPermissionCheck("vlan/v1", &vlanv1.VLAN{}))
+		Expect(report.Healthy()).To(BeTrue())
+		Expect(report.Err()).NotTo(HaveOccurred())
+		Expect(report.Duration).To(BeNumerically(">", 0))
+
+		Expect(report.Checks).To(HaveLen(2))
+		Expect(report.Checks[0].Name).To(Equal(diagnostics.CheckConnectivity))
+		Expect(report.Checks[0].Status).To(Equal(diagnostics.StatusOK))
+		Expect(report.Checks[1].Name).To(Equal("vlan/v1"))
+		Expect(report.Checks[1].Status).To(Equal(diagnostics.StatusOK))
+		Expect(report.Checks[1].Latency).To(BeNumerically(">", 0))
+
+		Expect(srv.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("reports an engine failure when the echoed value does not match", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, ghttp.RespondWithJSONEncoded(200, "definitely not the sent value"))
+
+		report := diagnostics.Run(context.TODO(), a)
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Checks[0].Failure).To(Equal(diagnostics.FailureEngine))
+		Expect(report.Err()).To(MatchError(echo.ErrInvalidEchoResponse))
+	})
+
+	It("reports authentication failures when the token is not accepted", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, ghttp.RespondWith(401, "{}"))
+		srv.RouteToHandler("GET", "/api/vlan/v1/vlan.json/filtered", ghttp.RespondWith(401, "{}"))
+
+		report := diagnostics.Run(context.TODO(), a, diagnostics.WithPermissionCheck("vlan/v1", &vlanv1.VLAN{}))
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Checks[0].Failure).To(Equal(diagnostics.FailureAuthentication))
+		Expect(report.Checks[1].Status).To(Equal(diagnostics.StatusFailed))
+		Expect(report.Checks[1].Failure).To(Equal(diagnostics.FailureAuthentication))
+	})
+
+	It("reports permission failures per check", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, echoHandler)
+		srv.RouteToHandler("GET", "/api/vlan/v1/vlan.json/filtered", ghttp.RespondWith(403, "{}"))
+
+		report := diagnostics.Run(context.TODO(), a, diagnostics.WithPermissionCheck("vlan/v1", &vlanv1.VLAN{}))
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Checks[0].Status).To(Equal(diagnostics.StatusOK))
+		Expect(report.Checks[1].Failure).To(Equal(diagnostics.FailurePermission))
+
+		err := report.Err()
+		Expect(err).To(MatchError(api.ErrAccessDenied))
+		Expect(err.Error()).To(ContainSubstring("vlan/v1 check failed (permission)"))
+	})
+
+	It("skips permission checks when the Engine is unreachable", func() {
+		srv.Close()
+
+		report := diagnostics.Run(context.TODO(), a, diagnostics.WithPermissionCheck("vlan/v1", &vlanv1.VLAN{}))
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Checks[0].Failure).To(Equal(diagnostics.FailureConnectivity))
+		Expect(report.Checks[1].Status).To(Equal(diagnostics.StatusSkipped))
+	})
+
+	It("reports TLS failures", func() {
+		tlsSrv := httptest.NewUnstartedServer(http.HandlerFunc(echoHandler))
+		tlsSrv.Config.ErrorLog = log.New(io.Discard, "", 0)
+		tlsSrv.StartTLS()
+		DeferCleanup(tlsSrv.Close)
+
+		a, err := api.NewAPI(api.WithClientOptions(
+			client.BaseURL(tlsSrv.URL),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		report := diagnostics.Run(context.TODO(), a)
+		Expect(report.Checks[0].Failure).To(Equal(diagnostics.FailureTLS))
+	})
+
+	It("reports timeouts", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(time.Second)
+		})
+
+		report := diagnostics.Run(context.TODO(), a, diagnostics.WithTimeout(50*time.Millisecond))
+		Expect(report.Checks[0].Failure).To(Equal(diagnostics.FailureTimeout))
+	})
+})
+
+var _ = Describe("Handler", func() {
+	var (
+		srv *ghttp.Server
+		a   types.API
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("responds with 200 and the report when healthy", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, echoHandler)
+
+		rec := httptest.NewRecorder()
+		diagnostics.Handler(a).ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		report := map[string]interface{}{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &report)).To(Succeed())
+		Expect(report).To(HaveKeyWithValue("healthy", true))
+		Expect(report["checks"]).To(ConsistOf(
+			SatisfyAll(
+				HaveKeyWithValue("name", diagnostics.CheckConnectivity),
+				HaveKeyWithValue("status", "ok"),
+				HaveKey("latency"),
+				Not(HaveKey("error")),
+			),
+		))
+	})
+
+	It("responds with 503 and the failures when not healthy", func() {
+		srv.RouteToHandler("PUT", echo.EchoPath, ghttp.RespondWith(401, "{}"))
+
+		rec := httptest.NewRecorder()
+		diagnostics.Handler(a).ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+
+		report := map[string]interface{}{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &report)).To(Succeed())
+		Expect(report).To(HaveKeyWithValue("healthy", false))
+		Expect(report["checks"]).To(ConsistOf(
+			SatisfyAll(
+				HaveKeyWithValue("status", "failed"),
+				HaveKeyWithValue("failure", "authentication"),
+				HaveKey("error"),
+			),
+		))
+	})
+})
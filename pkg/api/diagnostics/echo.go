@@ -0,0 +1,51 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/test/echo"
+)
+
+// echoRequest sends a value to the echo endpoint of the Engine, which responds with the same value. Only
+// Create operations are supported.
+type echoRequest struct {
+	Value string `json:"value"`
+
+	response string
+}
+
+// EndpointURL returns the URL of the echo endpoint.
+func (e *echoRequest) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationCreate {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	return url.Parse(echo.EchoPath)
+}
+
+// GetIdentifier returns an empty identifier, echo requests have none.
+func (e *echoRequest) GetIdentifier(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// FilterAPIRequest sends the request with PUT, as required by the echo endpoint.
+func (e *echoRequest) FilterAPIRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	req.Method = http.MethodPut
+	return req, nil
+}
+
+// DecodeAPIResponse decodes the echoed value.
+func (e *echoRequest) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
+	return json.NewDecoder(data).Decode(&e.response)
+}
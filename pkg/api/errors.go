@@ -1,11 +1,21 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
 )
 
 var (
@@ -23,6 +33,10 @@ var (
 
 	// ErrContextRequired is returned when a nil context was passed as argument.
 	ErrContextRequired = errors.New("no context given")
+
+	// ErrObjectModified is returned by Update operations made with the PartialUpdate option when the object on the
+	// engine was changed since the original object was retrieved.
+	ErrObjectModified = errors.New("object was modified on the engine since it was retrieved")
 )
 
 // RateLimitError occurs after a [http.TooManyRequests] status code got returned by the engine.
@@ -59,9 +73,92 @@ func IsRateLimitError(err error) bool {
 //
 // Ideally all errors returned by the API are transformed into EngineErrors, making HTTPError obsolete, as this
 // would completely decouple communicating with the Engine from using HTTP.
+//
+// EngineErrors decoded from the body of an error response carry the details sent by the Engine, retrievable
+// with Details. Depending on the response, they are returned as ValidationError, ConflictError or
+// QuotaExceededError, all of them unwrapping to the EngineError.
 type EngineError struct {
 	message string
 	wrapped error
+	details *EngineErrorDetails
+}
+
+// EngineErrorDetails are the details the Engine sent in the body of an error response.
+type EngineErrorDetails struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Code is the error code given by the Engine, usually the same as StatusCode.
+	Code int
+
+	// Message is the error message given by the Engine.
+	Message string
+
+	// Validation contains the validation errors for single fields, sorted by the field name sent by the Engine.
+	Validation []FieldValidationError
+
+	// DebugSource is the source location of the error in the Engine, only sent by some Engine instances.
+	DebugSource string
+}
+
+// FieldValidationError is a validation error the Engine returned for a single field.
+type FieldValidationError struct {
+	// Field is the path to the field in the Go struct of the Object, with the names of nested fields and the
+	// indexes of slice elements separated by dots, e.g. "Name" or "Rules.0.Name". It is empty when the field
+	// sent by the Engine could not be mapped to a field of the Object.
+	Field string
+
+	// EngineField is the name of the field as sent by the Engine.
+	EngineField string
+
+	// Message describes why the value of the field is invalid.
+	Message string
+}
+
+// Error returns the field and message.
+func (e FieldValidationError) Error() string {
+	field := e.Field
+	if field == "" {
+		field = e.EngineField
+	}
+
+	return fmt.Sprintf("%v: %v", field, e.Message)
+}
+
+// ValidationError is returned when the Engine rejected the request because of invalid fields, listed in
+// Fields.
+type ValidationError struct {
+	EngineError
+
+	// Fields contains the validation errors for single fields, the same as in Details().Validation.
+	Fields []FieldValidationError
+}
+
+// Unwrap returns the EngineError, making it retrievable with errors.As.
+func (e *ValidationError) Unwrap() error {
+	return e.EngineError
+}
+
+// ConflictError is returned when the request conflicts with the current state of the resource, for example
+// because an Object with the same unique attributes already exists.
+type ConflictError struct {
+	EngineError
+}
+
+// Unwrap returns the EngineError, making it retrievable with errors.As.
+func (e *ConflictError) Unwrap() error {
+	return e.EngineError
+}
+
+// QuotaExceededError is returned when the request would exceed a quota of the customer. It is detected by the
+// status code 402 Payment Required or the message of the Engine mentioning a quota.
+type QuotaExceededError struct {
+	EngineError
+}
+
+// Unwrap returns the EngineError, making it retrievable with errors.As.
+func (e *QuotaExceededError) Unwrap() error {
+	return e.EngineError
 }
 
 var (
@@ -91,6 +188,12 @@ func (e EngineError) Unwrap() error {
 	return e.wrapped
 }
 
+// Details returns the details the Engine sent in the body of the error response, nil if the EngineError was not
+// decoded from a response (like ErrNotFound) or the Engine did not send any.
+func (e EngineError) Details() *EngineErrorDetails {
+	return e.details
+}
+
 // HTTPError is an not-specially-implemented EngineError for a given status code. Ideally this is not used
 // because every returned error is mapped to an ErrSomething package variable, decoupling error handling from
 // the transport protocol.
@@ -153,8 +256,15 @@ func (e HTTPError) Is(other error) bool {
 	}
 }
 
-// ErrorFromResponse creates a new HTTPError from the given response.
+// ErrorFromResponse creates a new HTTPError from the given response, wrapping an EngineError decoded from the
+// response body if possible.
 func ErrorFromResponse(req *http.Request, res *http.Response) error {
+	return errorFromResponse(req, res, nil)
+}
+
+// errorFromResponse creates a new HTTPError from the given response, mapping the fields of validation errors to
+// the fields of the given Object, if any.
+func errorFromResponse(req *http.Request, res *http.Response, o types.Object) error {
 	var specificError error
 
 	switch res.StatusCode {
@@ -169,12 +279,184 @@ func ErrorFromResponse(req *http.Request, res *http.Response) error {
 
 	// We check for higher than 300 because redirects should be handled already
 	if res.StatusCode > 300 || specificError != nil {
+		if engineError := decodeEngineError(res, o, specificError); engineError != nil {
+			message := fmt.Sprintf("Engine returned an error: %v (%v): %v", res.Status, res.StatusCode, engineError)
+			return newHTTPError(req, res, engineError, &message)
+		}
+
 		return newHTTPError(req, res, specificError, nil)
 	}
 
 	return nil
 }
 
+// maxErrorBodySize limits how much of the body of error responses is decoded.
+const maxErrorBodySize = 1 << 20
+
+// decodeEngineError decodes the body of an error response into an EngineError wrapping the given error, returning
+// nil if the body is not an Engine error. Validation messages of secret fields are redacted. The body of the
+// response stays readable.
+func decodeEngineError(res *http.Response, o types.Object, wrapped error) error {
+	if res.Body == nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+
+	// restore the body for callers reading it after us, like users of ErrorFromResponse
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), res.Body), res.Body}
+
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+
+	redactedFields := append([]string{}, client.DefaultRedactedFields...)
+	if o != nil {
+		redactedFields = append(redactedFields, client.SecretFields(o)...)
+	}
+	data = client.RedactJSON(data, redactedFields...)
+
+	var body struct {
+		Error struct {
+			Code       int               `json:"code"`
+			Message    string            `json:"message"`
+			Validation map[string]string `json:"validation"`
+		} `json:"error"`
+		Debug struct {
+			Source string `json:"source"`
+		} `json:"debug"`
+	}
+
+	if err := json.Unmarshal(data, &body); err != nil || (body.Error.Message == "" && len(body.Error.Validation) == 0) {
+		return nil
+	}
+
+	details := EngineErrorDetails{
+		StatusCode:  res.StatusCode,
+		Code:        body.Error.Code,
+		Message:     body.Error.Message,
+		DebugSource: body.Debug.Source,
+	}
+
+	for engineField, message := range body.Error.Validation {
+		details.Validation = append(details.Validation, FieldValidationError{
+			Field:       goFieldPath(o, engineField),
+			EngineField: engineField,
+			Message:     message,
+		})
+	}
+
+	sort.Slice(details.Validation, func(i, j int) bool {
+		return details.Validation[i].EngineField < details.Validation[j].EngineField
+	})
+
+	engineError := EngineError{
+		message: details.message(),
+		wrapped: wrapped,
+		details: &details,
+	}
+
+	switch {
+	case res.StatusCode == http.StatusPaymentRequired || strings.Contains(strings.ToLower(details.Message), "quota"):
+		return &QuotaExceededError{EngineError: engineError}
+	case res.StatusCode == http.StatusConflict:
+		return &ConflictError{EngineError: engineError}
+	case len(details.Validation) > 0:
+		return &ValidationError{EngineError: engineError, Fields: details.Validation}
+	default:
+		return engineError
+	}
+}
+
+// message returns the message of the Engine, followed by the validation errors.
+func (d EngineErrorDetails) message() string {
+	validation := make([]string, 0, len(d.Validation))
+	for _, v := range d.Validation {
+		validation = append(validation, v.Error())
+	}
+
+	switch {
+	case len(validation) == 0:
+		return d.Message
+	case d.Message == "":
+		return strings.Join(validation, "; ")
+	default:
+		return fmt.Sprintf("%v (%v)", d.Message, strings.Join(validation, "; "))
+	}
+}
+
+// goFieldPath maps the path of a field in the JSON document of the given Object (separated by dots) to the path
+// of the field in the Go struct, returning an empty string if any part of the path is unknown.
+func goFieldPath(o types.Object, engineField string) string {
+	if o == nil {
+		return ""
+	}
+
+	t := reflect.TypeOf(o)
+	path := make([]string, 0, strings.Count(engineField, ".")+1)
+
+	for _, key := range strings.Split(engineField, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(key); err != nil {
+				return ""
+			}
+
+			path = append(path, key)
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := fieldByJSONName(t, key)
+			if !ok {
+				return ""
+			}
+
+			path = append(path, field.Name)
+			t = field.Type
+		default:
+			return ""
+		}
+	}
+
+	return strings.Join(path, ".")
+}
+
+// fieldByJSONName returns the exported field of the given struct type encoded with the given name, including
+// fields of embedded structs.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+			if f, ok := fieldByJSONName(field.Type, name); ok {
+				return f, true
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+
+		if jsonName == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
 // NewHTTPError creates a new HTTPError instance with the given values, which is mostly useful for mock-testing.
 func NewHTTPError(status int, method string, url *url.URL, wrapped error) error {
 	return HTTPError{
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
 	"go.anx.io/go-anxcloud/pkg/lbaas/backend"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -196,3 +199,102 @@ var _ = Describe("EngineError", func() {
 		})
 	})
 })
+
+var _ = Describe("decoding Engine error responses", func() {
+	req := httptest.NewRequest("POST", "/", nil)
+
+	response := func(statusCode int, body string) *http.Response {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(statusCode)
+		_, _ = rec.WriteString(body)
+		return rec.Result()
+	}
+
+	It("returns a ValidationError with the fields mapped to the Object", func() {
+		res := response(422, `{"error":{"code":422,"message":"Invalid input","validation":{"name":"must not be empty","automation_rules.0.identifier":"unknown rule","foo":"unknown field"}},"debug":{"source":"backend.py:42"}}`)
+		err := errorFromResponse(req, res, &lbaasv1.Backend{})
+		Expect(err).To(MatchError("Engine returned an error: 422 Unprocessable Entity (422): Invalid input (AutomationRules.0.Identifier: unknown rule; foo: unknown field; Name: must not be empty)"))
+
+		var he HTTPError
+		Expect(errors.As(err, &he)).To(BeTrue())
+		Expect(he.StatusCode()).To(Equal(422))
+
+		var validationError *ValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.Fields).To(Equal([]FieldValidationError{
+			{Field: "AutomationRules.0.Identifier", EngineField: "automation_rules.0.identifier", Message: "unknown rule"},
+			{Field: "", EngineField: "foo", Message: "unknown field"},
+			{Field: "Name", EngineField: "name", Message: "must not be empty"},
+		}))
+
+		var engineError EngineError
+		Expect(errors.As(err, &engineError)).To(BeTrue())
+		Expect(engineError.Details()).To(Equal(&EngineErrorDetails{
+			StatusCode:  422,
+			Code:        422,
+			Message:     "Invalid input",
+			Validation:  validationError.Fields,
+			DebugSource: "backend.py:42",
+		}))
+	})
+
+	It("keeps the response body readable", func() {
+		body := `{"error":{"code":500,"message":"Internal error"}}`
+		res := response(500, body)
+		Expect(ErrorFromResponse(req, res)).To(MatchError(ContainSubstring("Internal error")))
+
+		data, err := io.ReadAll(res.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(body))
+		Expect(res.Body.Close()).To(Succeed())
+	})
+
+	It("returns a ConflictError for status code 409", func() {
+		err := errorFromResponse(req, response(409, `{"error":{"code":409,"message":"Name already in use"}}`), nil)
+
+		var conflictError *ConflictError
+		Expect(errors.As(err, &conflictError)).To(BeTrue())
+		Expect(conflictError.Details().Message).To(Equal("Name already in use"))
+	})
+
+	DescribeTable("returns a QuotaExceededError",
+		func(statusCode int, message string) {
+			err := errorFromResponse(req, response(statusCode, fmt.Sprintf(`{"error":{"code":%v,"message":%q}}`, statusCode, message)), nil)
+
+			var quotaError *QuotaExceededError
+			Expect(errors.As(err, &quotaError)).To(BeTrue())
+			Expect(quotaError.Error()).To(Equal(message))
+		},
+		Entry("for status code 402", 402, "Payment required"),
+		Entry("for messages mentioning a quota", 400, "VM quota exceeded"),
+	)
+
+	It("keeps ErrNotFound matchable", func() {
+		err := errorFromResponse(req, response(404, `{"error":{"code":404,"message":"Backend not found"}}`), nil)
+		Expect(err).To(MatchError(ErrNotFound))
+		Expect(IgnoreNotFound(err)).To(Succeed())
+
+		var engineError EngineError
+		Expect(errors.As(err, &engineError)).To(BeTrue())
+		Expect(engineError.Details().Message).To(Equal("Backend not found"))
+	})
+
+	It("redacts secret values in the body", func() {
+		err := errorFromResponse(req, response(400, `{"error":{"code":400,"message":"Invalid input","validation":{"password":"hunter2 is too short"}}}`), nil)
+		Expect(err.Error()).NotTo(ContainSubstring("hunter2"))
+	})
+
+	DescribeTable("ignores bodies not sent by the Engine",
+		func(body string) {
+			err := errorFromResponse(req, response(500, body), nil)
+			Expect(err).To(MatchError("Engine returned an error: 500 Internal Server Error (500)"))
+
+			var engineError EngineError
+			Expect(errors.As(err, &engineError)).To(BeFalse())
+		},
+		Entry("empty body", ""),
+		Entry("HTML body", "<html><body>Internal Server Error</body></html>"),
+		Entry("other JSON document", `{"status":"error"}`),
+		Entry("oversized body", `{"error":{"message":"`+strings.Repeat("a", maxErrorBodySize)+`"}}`),
+	)
+})
@@ -0,0 +1,503 @@
+// Package fakeengine implements an in-memory fake of the Engine endpoints generic client Objects are created,
+// retrieved, listed, updated and destroyed with, allowing to test code using the generic API offline.
+//
+// The endpoints are derived from the Objects themselves: the paths from their EndpointURL for each operation, the
+// identifier from the field tagged `anxcloud:"identifier"` and the filters from the fields tagged
+// `anxcloud:"filterable"`:
+//
+//	engine := fakeengine.New()
+//	if err := engine.Register(&vlanv1.VLAN{}); err != nil {
+//		return err
+//	}
+//
+//	server := httptest.NewServer(engine)
+//	defer server.Close()
+//
+//	a, err := api.NewAPI(api.WithClientOptions(
+//		client.BaseURL(server.URL),
+//		client.IgnoreMissingToken(),
+//	))
+//
+// Objects are stored as the JSON documents sent to the Engine, with the identifier added on Create. Update (PUT or
+// PATCH) merges the sent document into the stored one. Responses are the stored documents, List responds with a
+// plain array or with the paginated format when the page and limit query parameters are given.
+package fakeengine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// ErrUnsupportedObject is returned by Register for Objects the fake Engine cannot derive the endpoints for, e.g.
+// because their EndpointURL requires fields to be set.
+var ErrUnsupportedObject = errors.New("object not supported by the fake engine")
+
+// Engine is a http.Handler serving the endpoints of the registered Objects.
+type Engine struct {
+	mu        sync.Mutex
+	resources []*resource
+	counter   uint64
+}
+
+type resource struct {
+	objectType reflect.Type
+	typeName   string
+
+	// collectionPaths are the paths Objects are created at and listed from.
+	collectionPaths map[string]bool
+
+	// itemPaths are the paths single Objects are retrieved, updated and destroyed below.
+	itemPaths map[string]bool
+
+	// identifier is the name of the identifier field in the JSON documents.
+	identifier string
+
+	// filters maps the names of filters to the names of the fields in the JSON documents.
+	filters map[string]string
+
+	// fields maps the names of the fields in the JSON documents to their types in the Object.
+	fields map[string]reflect.Type
+
+	objects map[string]map[string]interface{}
+	order   []string
+}
+
+// New creates an Engine without any registered Objects.
+func New() *Engine {
+	return &Engine{}
+}
+
+// Register adds the endpoints of the type of the given Object, returning an error wrapping ErrUnsupportedObject if
+// the endpoints cannot be derived from it. The given Object is only used to retrieve the endpoints and not stored,
+// Objects with endpoints depending on their fields can be registered with these fields set. Objects have to support
+// Create and List operations, the endpoints of other operations the Object returns an error for are not served.
+func (e *Engine) Register(o types.Object) error {
+	objectType := reflect.TypeOf(o)
+	for objectType.Kind() == reflect.Ptr {
+		objectType = objectType.Elem()
+	}
+
+	if objectType.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %v is not a struct", ErrUnsupportedObject, objectType)
+	}
+
+	r := resource{
+		objectType:      objectType,
+		typeName:        objectType.Name(),
+		collectionPaths: make(map[string]bool),
+		itemPaths:       make(map[string]bool),
+		filters:         make(map[string]string),
+		fields:          make(map[string]reflect.Type),
+		objects:         make(map[string]map[string]interface{}),
+	}
+
+	operations := map[types.Operation]types.Options{
+		types.OperationCreate:  &types.CreateOptions{},
+		types.OperationList:    &types.ListOptions{},
+		types.OperationGet:     &types.GetOptions{},
+		types.OperationUpdate:  &types.UpdateOptions{},
+		types.OperationDestroy: &types.DestroyOptions{},
+	}
+
+	for op, opts := range operations {
+		ctx := types.ContextWithOperation(context.Background(), op)
+		ctx = types.ContextWithOptions(ctx, opts)
+
+		u, err := o.EndpointURL(ctx)
+		if err != nil && op != types.OperationCreate && op != types.OperationList {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("%w: retrieving endpoint of %v for %v: %v", ErrUnsupportedObject, r.typeName, op, err)
+		}
+
+		// empty path segments are left by fields the endpoint depends on
+		if strings.Contains(u.Path, "//") {
+			return fmt.Errorf("%w: endpoint of %v for %v depends on fields of the object", ErrUnsupportedObject, r.typeName, op)
+		}
+
+		// the generic API joins the path with the path of the base URL, making it absolute
+		p := path.Join("/", u.Path)
+		if op == types.OperationCreate || op == types.OperationList {
+			r.collectionPaths[p] = true
+		} else {
+			r.itemPaths[p] = true
+		}
+	}
+
+	parseFields(objectType, &r)
+
+	if r.identifier == "" {
+		return fmt.Errorf("%w: %v has no identifier field", ErrUnsupportedObject, r.typeName)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.resources = append(e.resources, &r)
+
+	return nil
+}
+
+// parseFields retrieves the identifier and filters of the resource from the fields of the given struct type and
+// the structs embedded into it.
+func parseFields(t reflect.Type, r *resource) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			parseFields(field.Type, r)
+			continue
+		}
+
+		jsonName := jsonFieldName(field)
+		if jsonName == "-" || !field.IsExported() {
+			continue
+		}
+
+		r.fields[jsonName] = field.Type
+
+		if types.IsIdentifierField(field) {
+			r.identifier = jsonName
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("anxcloud")
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+
+		if parts[0] == "filterable" {
+			filterName := jsonName
+			if len(parts) >= 2 && parts[1] != "" {
+				filterName = parts[1]
+			}
+
+			r.filters[filterName] = jsonName
+		}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		if name := strings.Split(tag, ",")[0]; name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// ServeHTTP handles the requests for the registered Objects, responding with 404 for unknown paths and Objects.
+func (e *Engine) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p := strings.TrimSuffix(req.URL.Path, "/")
+
+	for _, r := range e.resources {
+		if r.collectionPaths[p] {
+			switch req.Method {
+			case http.MethodPost:
+				e.create(w, req, r)
+			case http.MethodGet:
+				r.list(w, req)
+			default:
+				respondError(w, http.StatusMethodNotAllowed, "method %v not allowed for %v", req.Method, p)
+			}
+			return
+		}
+	}
+
+	for _, r := range e.resources {
+		for itemPath := range r.itemPaths {
+			identifier, ok := strings.CutPrefix(p, itemPath+"/")
+			if !ok || identifier == "" || strings.Contains(identifier, "/") {
+				continue
+			}
+
+			identifier, _ = url.PathUnescape(identifier)
+
+			object, ok := r.objects[identifier]
+			if !ok {
+				respondError(w, http.StatusNotFound, "%v %q not found", r.typeName, identifier)
+				return
+			}
+
+			switch req.Method {
+			case http.MethodGet:
+				respondJSON(w, http.StatusOK, object)
+			case http.MethodPut, http.MethodPatch:
+				r.update(w, req, identifier, object)
+			case http.MethodDelete:
+				r.destroy(identifier)
+				respondJSON(w, http.StatusOK, map[string]interface{}{})
+			default:
+				respondError(w, http.StatusMethodNotAllowed, "method %v not allowed for %v", req.Method, p)
+			}
+			return
+		}
+	}
+
+	respondError(w, http.StatusNotFound, "no resource registered for %v", p)
+}
+
+func (e *Engine) create(w http.ResponseWriter, req *http.Request, r *resource) {
+	object, ok := decodeObject(w, req)
+	if !ok {
+		return
+	}
+	r.expandReferences(object)
+
+	// some Objects are identified by a field set by the user, e.g. their name
+	identifier, _ := object[r.identifier].(string)
+	if identifier == "" {
+		e.counter++
+		identifier = fmt.Sprintf("%032x", e.counter)
+		object[r.identifier] = identifier
+	} else if _, exists := r.objects[identifier]; exists {
+		respondError(w, http.StatusConflict, "%v %q already exists", r.typeName, identifier)
+		return
+	}
+
+	r.objects[identifier] = object
+	r.order = append(r.order, identifier)
+
+	respondJSON(w, http.StatusOK, object)
+}
+
+func (r *resource) update(w http.ResponseWriter, req *http.Request, identifier string, object map[string]interface{}) {
+	update, ok := decodeObject(w, req)
+	if !ok {
+		return
+	}
+	r.expandReferences(update)
+
+	for k, v := range update {
+		object[k] = v
+	}
+	object[r.identifier] = identifier
+
+	respondJSON(w, http.StatusOK, object)
+}
+
+// expandReferences replaces the identifiers of referenced Objects in the document with objects containing the
+// identifier, as the Engine responds with the referenced Objects while most Objects send only their identifiers.
+// Lists of references may be sent as arrays or comma-separated strings of identifiers.
+func (r *resource) expandReferences(object map[string]interface{}) {
+	for name, value := range object {
+		t, ok := r.fields[name]
+		if !ok || decodable(value, t) {
+			continue
+		}
+
+		var expanded interface{}
+
+		switch v := value.(type) {
+		case string:
+			expanded = reference(v)
+
+			if !decodable(expanded, t) {
+				references := make([]interface{}, 0)
+				for _, identifier := range strings.Split(v, ",") {
+					if identifier != "" {
+						references = append(references, reference(identifier))
+					}
+				}
+				expanded = references
+			}
+		case []interface{}:
+			references := make([]interface{}, 0, len(v))
+			for _, e := range v {
+				if identifier, ok := e.(string); ok {
+					references = append(references, reference(identifier))
+				} else {
+					references = append(references, e)
+				}
+			}
+			expanded = references
+		default:
+			continue
+		}
+
+		if decodable(expanded, t) {
+			object[name] = expanded
+		}
+	}
+}
+
+func reference(identifier string) map[string]interface{} {
+	return map[string]interface{}{"identifier": identifier}
+}
+
+// decodable checks if the given value of a JSON document can be decoded into the given type.
+func decodable(value interface{}, t reflect.Type) bool {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	return json.Unmarshal(data, reflect.New(t).Interface()) == nil
+}
+
+func (r *resource) destroy(identifier string) {
+	delete(r.objects, identifier)
+
+	for i, id := range r.order {
+		if id == identifier {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (r *resource) list(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	filters := make(url.Values)
+	if encoded := query.Get("filters"); encoded != "" {
+		if f, err := url.ParseQuery(encoded); err == nil {
+			filters = f
+		}
+	}
+
+	for name := range r.filters {
+		if v := query.Get(name); v != "" {
+			filters.Set(name, v)
+		}
+	}
+
+	objects := make([]map[string]interface{}, 0, len(r.order))
+	for _, identifier := range r.order {
+		if r.matches(r.objects[identifier], filters) {
+			objects = append(objects, r.objects[identifier])
+		}
+	}
+
+	page, pageErr := strconv.Atoi(query.Get("page"))
+	limit, limitErr := strconv.Atoi(query.Get("limit"))
+	if pageErr != nil || limitErr != nil || page < 1 || limit < 1 {
+		respondJSON(w, http.StatusOK, objects)
+		return
+	}
+
+	totalItems := len(objects)
+	start := (page - 1) * limit
+	if start > totalItems {
+		start = totalItems
+	}
+	end := start + limit
+	if end > totalItems {
+		end = totalItems
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"page":        page,
+		"limit":       limit,
+		"total_items": totalItems,
+		"total_pages": (totalItems + limit - 1) / limit,
+		"data":        objects[start:end],
+	})
+}
+
+// matches checks the object against the given filters. Filters on fields not in the stored document are ignored,
+// as the document sent on Create might differ from the Object.
+func (r *resource) matches(object map[string]interface{}, filters url.Values) bool {
+	for name, values := range filters {
+		field, ok := r.filters[name]
+		if !ok {
+			continue
+		}
+
+		value, ok := object[field]
+		if !ok {
+			continue
+		}
+
+		if !valueMatches(value, values[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valueMatches compares a value of a JSON document with a filter value, matching referenced Objects by their
+// identifier and arrays if any of their elements matches.
+func valueMatches(value interface{}, filter string) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, e := range v {
+			if valueMatches(e, filter) {
+				return true
+			}
+		}
+		return false
+	case map[string]interface{}:
+		return fmt.Sprint(v["identifier"]) == filter
+	default:
+		return fmt.Sprint(v) == filter
+	}
+}
+
+// Objects returns the identifiers of the stored Objects of the type of the given Object, sorted.
+func (e *Engine) Objects(o types.Object) []string {
+	objectType := reflect.TypeOf(o)
+	for objectType.Kind() == reflect.Ptr {
+		objectType = objectType.Elem()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ret := make([]string, 0)
+	for _, r := range e.resources {
+		if r.objectType == objectType {
+			for identifier := range r.objects {
+				ret = append(ret, identifier)
+			}
+		}
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+func decodeObject(w http.ResponseWriter, req *http.Request) (map[string]interface{}, bool) {
+	object := make(map[string]interface{})
+	if err := json.NewDecoder(req.Body).Decode(&object); err != nil {
+		respondError(w, http.StatusBadRequest, "decoding request body: %v", err)
+		return nil, false
+	}
+
+	return object, true
+}
+
+func respondJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func respondError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	respondJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    status,
+			"message": fmt.Sprintf(format, args...),
+		},
+	})
+}
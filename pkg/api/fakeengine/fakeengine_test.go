@@ -0,0 +1,153 @@
+package fakeengine_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/fakeengine"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("Engine", func() {
+	var engine *fakeengine.Engine
+	var server *httptest.Server
+	var a api.API
+
+	BeforeEach(func() {
+		engine = fakeengine.New()
+		Expect(engine.Register(&lbaasv1.ACL{})).To(Succeed())
+
+		server = httptest.NewServer(engine)
+		DeferCleanup(server.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(server.URL),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("rejects objects it cannot derive the endpoints for",
+		func(o types.Object) {
+			Expect(engine.Register(o)).To(MatchError(fakeengine.ErrUnsupportedObject))
+		},
+		Entry("read-only objects", &corev1.Location{}),
+		Entry("objects with endpoints depending on fields", &frontierv1.Deployment{}),
+	)
+
+	It("registers objects with the fields their endpoints depend on", func() {
+		Expect(engine.Register(&frontierv1.Deployment{APIIdentifier: "api-01"})).To(Succeed())
+
+		deployment := frontierv1.Deployment{APIIdentifier: "api-01", Slug: "prod"}
+		Expect(a.Create(context.TODO(), &deployment)).To(Succeed())
+		Expect(engine.Objects(&deployment)).To(HaveLen(1))
+	})
+
+	It("creates, retrieves, updates and destroys objects", func() {
+		acl := lbaasv1.ACL{Name: "acl-01", Frontend: lbaasv1.Frontend{Identifier: "frontend-01"}}
+		Expect(a.Create(context.TODO(), &acl)).To(Succeed())
+		Expect(acl.Identifier).NotTo(BeEmpty())
+		Expect(engine.Objects(&acl)).To(ConsistOf(acl.Identifier))
+
+		retrieved := lbaasv1.ACL{Identifier: acl.Identifier}
+		Expect(a.Get(context.TODO(), &retrieved)).To(Succeed())
+		Expect(retrieved.Name).To(Equal("acl-01"))
+		Expect(retrieved.Frontend.Identifier).To(Equal("frontend-01"))
+
+		acl.Name = "acl-02"
+		Expect(a.Update(context.TODO(), &acl)).To(Succeed())
+		Expect(a.Get(context.TODO(), &retrieved)).To(Succeed())
+		Expect(retrieved.Name).To(Equal("acl-02"))
+
+		Expect(a.Destroy(context.TODO(), &acl)).To(Succeed())
+		Expect(engine.Objects(&acl)).To(BeEmpty())
+
+		err := a.Get(context.TODO(), &retrieved)
+		Expect(err).To(MatchError(api.ErrNotFound))
+	})
+
+	It("merges the document sent with PATCH requests", func() {
+		acl := lbaasv1.ACL{Name: "acl-01", ParentType: "frontend"}
+		Expect(a.Create(context.TODO(), &acl)).To(Succeed())
+
+		req, err := http.NewRequest(http.MethodPatch, server.URL+"/api/LBaaS/v1/ACL.json/"+acl.Identifier, strings.NewReader(`{"name":"acl-02"}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		var updated map[string]interface{}
+		Expect(json.NewDecoder(res.Body).Decode(&updated)).To(Succeed())
+		Expect(updated).To(HaveKeyWithValue("name", "acl-02"))
+		Expect(updated).To(HaveKeyWithValue("parent_type", "frontend"))
+		Expect(updated).To(HaveKeyWithValue("identifier", acl.Identifier))
+	})
+
+	Context("with multiple objects", func() {
+		BeforeEach(func() {
+			for _, frontend := range []string{"frontend-01", "frontend-02", "frontend-01"} {
+				acl := lbaasv1.ACL{Name: "acl", Frontend: lbaasv1.Frontend{Identifier: frontend}}
+				Expect(a.Create(context.TODO(), &acl)).To(Succeed())
+			}
+		})
+
+		listFrontends := func(filter *lbaasv1.ACL, opts ...types.ListOption) []string {
+			var oc types.ObjectChannel
+			Expect(a.List(context.TODO(), filter, append(opts, api.ObjectChannel(&oc))...)).To(Succeed())
+
+			frontends := make([]string, 0)
+			for retriever := range oc {
+				var acl lbaasv1.ACL
+				Expect(retriever(&acl)).To(Succeed())
+				frontends = append(frontends, acl.Frontend.Identifier)
+			}
+
+			return frontends
+		}
+
+		It("lists all objects", func() {
+			Expect(listFrontends(&lbaasv1.ACL{})).To(Equal([]string{"frontend-01", "frontend-02", "frontend-01"}))
+		})
+
+		It("lists objects matching the filters", func() {
+			filter := lbaasv1.ACL{Frontend: lbaasv1.Frontend{Identifier: "frontend-01"}}
+			Expect(listFrontends(&filter)).To(Equal([]string{"frontend-01", "frontend-01"}))
+		})
+
+		It("lists objects in pages", func() {
+			var pi types.PageInfo
+			Expect(a.List(context.TODO(), &lbaasv1.ACL{}, api.Paged(1, 2, &pi))).To(Succeed())
+
+			var page []lbaasv1.ACL
+			Expect(pi.Next(&page)).To(BeTrue())
+			Expect(page).To(HaveLen(2))
+			Expect(pi.TotalItems()).To(BeEquivalentTo(3))
+			Expect(pi.TotalPages()).To(BeEquivalentTo(2))
+
+			Expect(pi.Next(&page)).To(BeTrue())
+			Expect(page).To(HaveLen(1))
+			Expect(pi.Next(&page)).To(BeFalse())
+		})
+	})
+
+	It("responds with 404 for unknown paths", func() {
+		res, err := http.Get(server.URL + "/api/unknown/v1/resource.json")
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,13 @@
+package fakeengine_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFakeEngine(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FakeEngine Suite")
+}
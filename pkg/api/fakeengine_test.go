@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/fakeengine"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+// testEngine is a fake engine serving ACLs, used by the specs of the features built on top of the generic API.
+type testEngine struct {
+	*fakeengine.Engine
+
+	server *httptest.Server
+}
+
+// startTestEngine starts a fake engine serving ACLs, stopped when the current spec is done. The engine is wrapped
+// with the given function when set, to count or modify the requests and responses.
+func startTestEngine(wrap func(engine http.Handler) http.Handler) *testEngine {
+	engine := fakeengine.New()
+	Expect(engine.Register(&lbaasv1.ACL{})).To(Succeed())
+
+	var handler http.Handler = engine
+	if wrap != nil {
+		handler = wrap(engine)
+	}
+
+	server := httptest.NewServer(handler)
+	DeferCleanup(server.Close)
+
+	return &testEngine{Engine: engine, server: server}
+}
+
+// newAPI returns a generic API using the fake engine, configured with the given options.
+func (e *testEngine) newAPI(opts ...NewAPIOption) types.API {
+	a, err := NewAPI(append([]NewAPIOption{
+		WithClientOptions(
+			client.BaseURL(e.server.URL),
+			client.IgnoreMissingToken(),
+		),
+	}, opts...)...)
+	Expect(err).NotTo(HaveOccurred())
+
+	return a
+}
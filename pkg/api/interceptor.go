@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// Invocation describes an operation passed through the Interceptors configured with WithInterceptors.
+type Invocation struct {
+	Operation types.Operation
+	Object    types.Object
+
+	// Options of the operation, with all request options applied. It is a *types.GetOptions, *types.CreateOptions
+	// and so on, depending on the operation.
+	Options types.Options
+
+	// API the operation is made with, for Interceptors making further operations.
+	API types.API
+
+	requestFilters []RequestFilter
+}
+
+// RequestFilter is called with the requests built for an operation, returning the request to send.
+type RequestFilter func(*http.Request) (*http.Request, error)
+
+// OnRequest adds a function called with every request built for the Invocation, after the RequestFilterHook of
+// the Object, in the order they were added. List operations build a single request, the requests for further
+// pages are copies of it.
+func (i *Invocation) OnRequest(filter RequestFilter) {
+	i.requestFilters = append(i.requestFilters, filter)
+}
+
+// Invoker continues an operation, calling the next Interceptor or doing the operation itself.
+type Invoker func(ctx context.Context) error
+
+// Interceptor is called for every operation made with the API, after the request options were applied. It can
+// inspect and modify the Invocation, return an error instead of calling next to abort the operation or act on the
+// error returned by next. The context given to next has to be derived from the one given to the Interceptor.
+//
+// Operations made while handling an operation, like retrieving the objects for FullObjects or tagging them for
+// AutoTag, are passed through the Interceptors as separate Invocations.
+type Interceptor func(ctx context.Context, inv *Invocation, next Invoker) error
+
+// WithInterceptors configures the API to pass all operations through the given Interceptors. They are called in
+// the given order, the first Interceptor being the outermost one, and run inside the tracing span and metrics of
+// the operation. Calling WithInterceptors multiple times appends the Interceptors.
+func WithInterceptors(interceptors ...Interceptor) NewAPIOption {
+	return func(a *defaultAPI) {
+		a.interceptors = append(a.interceptors, interceptors...)
+	}
+}
+
+type invocationContextKey struct{}
+
+// intercept calls the operation through the configured Interceptors.
+func (a defaultAPI) intercept(ctx context.Context, op types.Operation, o types.Object, opts types.Options, operation Invoker) error {
+	if len(a.interceptors) == 0 {
+		return operation(ctx)
+	}
+
+	inv := &Invocation{
+		Operation: op,
+		Object:    o,
+		Options:   opts,
+		API:       a,
+	}
+
+	next := operation
+	for i := len(a.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := a.interceptors[i], next
+		next = func(ctx context.Context) error {
+			return interceptor(ctx, inv, inner)
+		}
+	}
+
+	return next(context.WithValue(ctx, invocationContextKey{}, inv))
+}
+
+// filterInterceptedRequest calls the RequestFilters added to the Invocation of the given context.
+func filterInterceptedRequest(ctx context.Context, request *http.Request) (*http.Request, error) {
+	inv, ok := ctx.Value(invocationContextKey{}).(*Invocation)
+	if !ok {
+		return request, nil
+	}
+
+	for _, filter := range inv.requestFilters {
+		var err error
+		if request, err = filter(request); err != nil {
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
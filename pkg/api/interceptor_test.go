@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+)
+
+var errInterceptorDenied = errors.New("denied by policy")
+
+var _ = Describe("WithInterceptors", func() {
+	var engine *testEngine
+
+	var mu sync.Mutex
+	var headers []string
+
+	BeforeEach(func() {
+		headers = nil
+
+		engine = startTestEngine(func(engine http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				headers = append(headers, r.Method+" "+r.Header.Get("X-Audit"))
+				mu.Unlock()
+
+				engine.ServeHTTP(w, r)
+			})
+		})
+	})
+
+	newAPI := func(interceptors ...Interceptor) types.API {
+		return engine.newAPI(WithInterceptors(interceptors...))
+	}
+
+	recorder := func(name string, calls *[]string) Interceptor {
+		return func(ctx context.Context, inv *Invocation, next Invoker) error {
+			*calls = append(*calls, name+" "+string(inv.Operation))
+			err := next(ctx)
+			*calls = append(*calls, name+" done")
+			return err
+		}
+	}
+
+	It("calls the interceptors in the given order", func() {
+		var calls []string
+		a := newAPI(recorder("first", &calls), recorder("second", &calls))
+
+		Expect(a.Create(context.TODO(), &lbaasv1.ACL{Name: "acl-01", ParentType: "frontend"})).To(Succeed())
+		Expect(calls).To(Equal([]string{"first Create", "second Create", "second done", "first done"}))
+	})
+
+	It("aborts operations when an interceptor returns an error", func() {
+		acl := lbaasv1.ACL{Name: "protected", ParentType: "frontend"}
+		Expect(newAPI().Create(context.TODO(), &acl)).To(Succeed())
+
+		a := newAPI(func(ctx context.Context, inv *Invocation, next Invoker) error {
+			if acl, ok := inv.Object.(*lbaasv1.ACL); ok && inv.Operation == types.OperationDestroy && acl.Name == "protected" {
+				return errInterceptorDenied
+			}
+
+			return next(ctx)
+		})
+
+		Expect(a.Destroy(context.TODO(), &acl)).To(MatchError(errInterceptorDenied))
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(HaveLen(1))
+	})
+
+	It("passes the applied options", func() {
+		var autoTags []string
+		var value interface{}
+
+		a := newAPI(func(ctx context.Context, inv *Invocation, next Invoker) error {
+			if options, ok := inv.Options.(*types.CreateOptions); ok {
+				autoTags = options.AutoTags
+			}
+
+			value, _ = inv.Options.Get("interceptor/test")
+			return nil
+		})
+
+		Expect(a.Create(context.TODO(), &lbaasv1.ACL{}, AutoTag("foo"), types.AnyOption(func(o types.Options) error {
+			return o.Set("interceptor/test", "value", false)
+		}))).To(Succeed())
+
+		Expect(autoTags).To(Equal([]string{"foo"}))
+		Expect(value).To(Equal("value"))
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(BeEmpty())
+	})
+
+	It("gives access to the requests of the operation", func() {
+		a := newAPI(func(ctx context.Context, inv *Invocation, next Invoker) error {
+			inv.OnRequest(func(req *http.Request) (*http.Request, error) {
+				req.Header.Set("X-Audit", string(inv.Operation))
+				return req, nil
+			})
+
+			return next(ctx)
+		})
+
+		acl := lbaasv1.ACL{Name: "acl-01", ParentType: "frontend"}
+		Expect(a.Create(context.TODO(), &acl)).To(Succeed())
+		Expect(a.Get(context.TODO(), &acl)).To(Succeed())
+
+		var oc types.ObjectChannel
+		Expect(a.List(context.TODO(), &lbaasv1.ACL{}, ObjectChannel(&oc))).To(Succeed())
+		for retriever := range oc {
+			Expect(retriever(&lbaasv1.ACL{})).To(Succeed())
+		}
+
+		// the second page is retrieved with a copy of the filtered request
+		Expect(headers).To(Equal([]string{"POST Create", "GET Get", "GET List", "GET List"}))
+	})
+
+	It("aborts operations when a request filter returns an error", func() {
+		a := newAPI(func(ctx context.Context, inv *Invocation, next Invoker) error {
+			inv.OnRequest(func(*http.Request) (*http.Request, error) {
+				return nil, errInterceptorDenied
+			})
+
+			return next(ctx)
+		})
+
+		Expect(a.Create(context.TODO(), &lbaasv1.ACL{Name: "acl-01"})).To(MatchError(errInterceptorDenied))
+		Expect(headers).To(BeEmpty())
+	})
+
+	It("passes nested operations through the interceptors", func() {
+		acl := lbaasv1.ACL{Name: "acl-01", ParentType: "frontend"}
+		Expect(newAPI().Create(context.TODO(), &acl)).To(Succeed())
+
+		var calls []string
+		a := newAPI(recorder("interceptor", &calls))
+
+		var oc types.ObjectChannel
+		Expect(a.List(context.TODO(), &lbaasv1.ACL{}, ObjectChannel(&oc), FullObjects(true))).To(Succeed())
+		for retriever := range oc {
+			var retrieved lbaasv1.ACL
+			Expect(retriever(&retrieved)).To(Succeed())
+		}
+
+		Expect(calls).To(Equal([]string{
+			"interceptor List", "interceptor done",
+			"interceptor Get", "interceptor done",
+		}))
+	})
+})
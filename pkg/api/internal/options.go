@@ -54,3 +54,24 @@ func (ato AutoTagOption) ApplyToCreate(o *types.CreateOptions) error {
 	o.AutoTags = ato
 	return nil
 }
+
+// ManagedTagsOption configures the Update operation to sync the tags of objects after updating them
+type ManagedTagsOption []string
+
+// ApplyToUpdate applies the ManagedTagsOption to the UpdateOptions
+func (mto ManagedTagsOption) ApplyToUpdate(o *types.UpdateOptions) error {
+	// always non-nil, an empty list of managed tags removes all tags
+	o.ManagedTags = append(make([]string, 0, len(mto)), mto...)
+	return nil
+}
+
+// PartialUpdateOption configures the Update operation to only send the fields changed compared to the original
+type PartialUpdateOption struct {
+	Original types.IdentifiedObject
+}
+
+// ApplyToUpdate applies the PartialUpdateOption to the UpdateOptions
+func (puo PartialUpdateOption) ApplyToUpdate(o *types.UpdateOptions) error {
+	o.Original = puo.Original
+	return nil
+}
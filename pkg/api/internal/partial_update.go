@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSONFields decodes the given JSON object into its top-level fields.
+func JSONFields(data []byte) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("decode fields of JSON object: %w", err)
+	}
+
+	return fields, nil
+}
+
+// ChangedFields returns the fields of modified with a value different from the one in original. Fields present in
+// original but missing in modified are returned with value null.
+func ChangedFields(original, modified map[string]json.RawMessage) map[string]json.RawMessage {
+	changed := make(map[string]json.RawMessage)
+
+	for name, value := range modified {
+		if !equalJSON(original[name], value) {
+			changed[name] = value
+		}
+	}
+
+	for name := range original {
+		if _, ok := modified[name]; !ok && !isNull(original[name]) {
+			changed[name] = json.RawMessage("null")
+		}
+	}
+
+	return changed
+}
+
+// ModifiedFields returns the sorted names of the fields with a value different in original and current, including
+// fields changed from or to null. Missing fields are handled like null ones, as the request body omits empty fields.
+func ModifiedFields(original, current map[string]json.RawMessage) []string {
+	modified := make([]string, 0)
+
+	for name, value := range current {
+		if !equalFieldValues(original[name], value) {
+			modified = append(modified, name)
+		}
+	}
+
+	for name, value := range original {
+		if _, ok := current[name]; !ok && !isNull(value) {
+			modified = append(modified, name)
+		}
+	}
+
+	sort.Strings(modified)
+	return modified
+}
+
+// MergeFields returns the given fields with the changes applied, removing fields changed to null.
+func MergeFields(fields, changes map[string]json.RawMessage) map[string]json.RawMessage {
+	merged := make(map[string]json.RawMessage, len(fields)+len(changes))
+
+	for name, value := range fields {
+		merged[name] = value
+	}
+
+	for name, value := range changes {
+		if isNull(value) {
+			delete(merged, name)
+		} else {
+			merged[name] = value
+		}
+	}
+
+	return merged
+}
+
+// equalFieldValues compares the given field values, handling missing fields like null ones.
+func equalFieldValues(a, b json.RawMessage) bool {
+	if isNull(a) || isNull(b) {
+		return isNull(a) && isNull(b)
+	}
+
+	return equalJSON(a, b)
+}
+
+func isNull(value json.RawMessage) bool {
+	return value == nil || equalJSON(value, json.RawMessage("null"))
+}
+
+// equalJSON compares the decoded values of the given JSON documents, ignoring formatting and the order of fields.
+func equalJSON(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	var av, bv interface{}
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return string(a) == string(b)
+	}
+
+	return reflect.DeepEqual(av, bv)
+}
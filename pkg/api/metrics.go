@@ -0,0 +1,55 @@
+package api
+
+import (
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+const (
+	// MetricStatusSuccess is the MetricLabelStatus of operations returning without error.
+	MetricStatusSuccess = "success"
+
+	// MetricStatusError is the MetricLabelStatus of operations returning an error.
+	MetricStatusError = "error"
+)
+
+// WithMetricReceiver configures the API to deliver operation metrics (client.MetricOperationDuration and
+// client.MetricOperationCount) to the given receiver. The receiver is given to the client, too, to receive
+// the request metrics.
+func WithMetricReceiver(r client.MetricReceiver) NewAPIOption {
+	return func(a *defaultAPI) {
+		a.metricReceiver = r
+		a.clientOptions = append(a.clientOptions, client.WithMetricReceiver(r))
+	}
+}
+
+// startOperationMetrics returns a function delivering the metrics of the given operation to the configured
+// receiver when called after the operation returned.
+func (a defaultAPI) startOperationMetrics(op types.Operation, o types.Object) func(error) {
+	if a.metricReceiver == nil || o == nil {
+		return func(error) {}
+	}
+
+	start := time.Now()
+
+	return func(err error) {
+		status := MetricStatusSuccess
+		if err != nil {
+			status = MetricStatusError
+		}
+
+		a.metricReceiver(
+			map[client.Metric]float64{
+				client.MetricOperationDuration: time.Since(start).Seconds(),
+				client.MetricOperationCount:    1,
+			},
+			map[client.MetricLabel]string{
+				client.MetricLabelResource:  types.ObjectTypeName(o),
+				client.MetricLabelOperation: string(op),
+				client.MetricLabelStatus:    status,
+			},
+		)
+	}
+}
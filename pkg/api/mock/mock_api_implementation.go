@@ -5,12 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/mitchellh/copystructure"
 	"go.anx.io/go-anxcloud/pkg/api"
-	"go.anx.io/go-anxcloud/pkg/api/mock/internal"
 	"go.anx.io/go-anxcloud/pkg/api/types"
 	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
 )
@@ -20,6 +20,8 @@ type mockAPI struct {
 	dataMu sync.Mutex // required to ensure no concurrent access to the map/mockDataView
 
 	hooks map[hookName][]Hook
+
+	protection *api.ProtectionPolicy
 }
 
 type APIOption func(*mockAPI)
@@ -38,6 +40,26 @@ func NewMockAPI(opts ...APIOption) API {
 	return a
 }
 
+// WithProtection makes the mock API deny Destroy operations, and Update operations if configured, on Objects
+// protected by the given policy with api.ErrProtectedResource, unless api.Force is passed - like an API created
+// with api.WithProtection.
+func WithProtection(policy api.ProtectionPolicy) APIOption {
+	return func(a *mockAPI) {
+		a.protection = &policy
+	}
+}
+
+// checkProtection checks the operation with the configured protection policy, it must not be called with dataMu
+// locked as the policy may retrieve the tags of the object.
+func (a *mockAPI) checkProtection(ctx context.Context, op types.Operation, o types.IdentifiedObject, opts types.Options) error {
+	if a.protection == nil {
+		return nil
+	}
+
+	_, err := a.protection.Check(ctx, a, op, o, opts)
+	return err
+}
+
 // Get retrieves an Object from MockAPIs local storage by its identifier
 func (a *mockAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
 	a.dataMu.Lock()
@@ -48,6 +70,22 @@ func (a *mockAPI) Get(ctx context.Context, o types.IdentifiedObject, opts ...typ
 		return fmt.Errorf("couldn't find object in mock api: %w", err)
 	}
 
+	// retrieving the core resource of any object returns its type and tags, e.g. for corev1.ListTags
+	if coreResource, isCoreResource := o.(*corev1.Resource); isCoreResource {
+		if _, isWrappedCoreResource := apiObject.wrapped.(*corev1.Resource); !isWrappedCoreResource {
+			typeIdentifier, _ := api.ResourceTypeOf(apiObject.wrapped)
+			tags := apiObject.Tags()
+			sort.Strings(tags)
+
+			*coreResource = corev1.Resource{
+				Identifier: coreResource.Identifier,
+				Type:       corev1.Type{Identifier: typeIdentifier},
+				Tags:       tags,
+			}
+			return nil
+		}
+	}
+
 	if apiObject.existing && reflect.TypeOf(apiObject.wrapped) == reflect.TypeOf(o) {
 		copy, err := copystructure.Copy(apiObject.wrapped)
 		if err != nil {
@@ -82,9 +120,10 @@ func listDataAggregation(o types.Object, data mockDataView) ([]types.Object, err
 				continue
 			}
 
+			typeIdentifier, _ := api.ResourceTypeOf(obj.wrapped)
 			objectsWithSameType = append(objectsWithSameType, &corev1.Resource{
 				Identifier: id,
-				Type:       *internal.ObjectCoreType(obj.wrapped),
+				Type:       corev1.Type{Identifier: typeIdentifier},
 			})
 		}
 	} else {
@@ -187,6 +226,12 @@ func (a *mockAPI) Create(ctx context.Context, o types.Object, opts ...types.Crea
 		h(ctx, a, o)
 	}
 
+	if v, ok := o.(types.ValidationHook); ok {
+		if err := v.Validate(ctx, types.OperationCreate); err != nil {
+			return err
+		}
+	}
+
 	options := types.CreateOptions{}
 	var err error
 	for _, opt := range opts {
@@ -236,6 +281,25 @@ func (a *mockAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...
 		h(ctx, a, o)
 	}
 
+	if v, ok := o.(types.ValidationHook); ok {
+		if err := v.Validate(ctx, types.OperationUpdate); err != nil {
+			return err
+		}
+	}
+
+	options := types.UpdateOptions{}
+	var err error
+	for _, opt := range opts {
+		err = errors.Join(err, opt.ApplyToUpdate(&options))
+	}
+	if err != nil {
+		return fmt.Errorf("apply request options: %w", err)
+	}
+
+	if err := a.checkProtection(ctx, types.OperationUpdate, o, &options); err != nil {
+		return err
+	}
+
 	a.dataMu.Lock()
 	defer a.dataMu.Unlock()
 
@@ -244,7 +308,12 @@ func (a *mockAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...
 		return fmt.Errorf("couldn't find object in mock api: %w", err)
 	}
 
-	merged, err := merge(apiObject.wrapped, o)
+	var merged interface{}
+	if options.Original != nil {
+		merged, err = mergePartial(apiObject.wrapped, options.Original, o)
+	} else {
+		merged, err = merge(apiObject.wrapped, o)
+	}
 	if err != nil {
 		if errors.Is(err, ErrMergeTypeMissmatch) {
 			return api.ErrNotFound
@@ -252,6 +321,13 @@ func (a *mockAPI) Update(ctx context.Context, o types.IdentifiedObject, opts ...
 		return err
 	}
 
+	if options.ManagedTags != nil {
+		apiObject.tags = make(map[string]interface{}, len(options.ManagedTags))
+		for _, tag := range options.ManagedTags {
+			apiObject.tags[tag] = true
+		}
+	}
+
 	apiObject.wrapped = merged.(types.Object)
 	apiObject.updatedCount++
 	apiObject.updatedTime = time.Now()
@@ -271,6 +347,19 @@ func updateSetMergedToInputObject(merged, o types.Object) error {
 
 // Destroy removes a types.Object from MockAPIs local storage
 func (a *mockAPI) Destroy(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+	options := types.DestroyOptions{}
+	var err error
+	for _, opt := range opts {
+		err = errors.Join(err, opt.ApplyToDestroy(&options))
+	}
+	if err != nil {
+		return fmt.Errorf("apply request options: %w", err)
+	}
+
+	if err := a.checkProtection(ctx, types.OperationDestroy, o, &options); err != nil {
+		return err
+	}
+
 	a.dataMu.Lock()
 	defer a.dataMu.Unlock()
 
@@ -226,6 +226,36 @@ var _ = Describe("Mock API implementation", func() {
 				TestFieldB: "updated-in-hook"},
 			))
 		})
+
+		It("only updates changed fields with api.PartialUpdate", func() {
+			id := a.FakeExisting(&testObject{TestFieldA: "some text A", TestFieldB: "some text B"})
+			original := testObject{Identifier: id, TestFieldA: "some text A", TestFieldB: "some text B"}
+
+			modified := original
+			modified.TestFieldA = "updated text A"
+
+			err := a.Update(context.TODO(), &modified, api.PartialUpdate(&original))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(a.Existing().Unwrap()[id]).To(Equal(&testObject{Identifier: id, TestFieldA: "updated text A", TestFieldB: "some text B"}))
+		})
+
+		It("returns api.ErrObjectModified with api.PartialUpdate when a field was cleared", func() {
+			id := a.FakeExisting(&testObject{TestFieldA: "some text A"})
+			original := testObject{Identifier: id, TestFieldA: "some text A", TestFieldB: "some text B"}
+
+			err := a.Update(context.TODO(), &testObject{Identifier: id, TestFieldA: "updated text A"}, api.PartialUpdate(&original))
+			Expect(err).To(MatchError(api.ErrObjectModified))
+			Expect(err).To(MatchError(ContainSubstring("TestFieldB")))
+		})
+
+		It("returns api.ErrObjectModified with api.PartialUpdate when the object was changed", func() {
+			id := a.FakeExisting(&testObject{TestFieldA: "some text A"})
+			original := testObject{Identifier: id, TestFieldA: "other text A"}
+
+			err := a.Update(context.TODO(), &testObject{Identifier: id, TestFieldA: "updated text A"}, api.PartialUpdate(&original))
+			Expect(err).To(MatchError(api.ErrObjectModified))
+			Expect(a.Existing().Unwrap()[id]).To(Equal(&testObject{Identifier: id, TestFieldA: "some text A"}))
+		})
 	})
 
 	Context("Destroy operations", func() {
@@ -258,4 +288,38 @@ var _ = Describe("Mock API implementation", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("with protected resources", func() {
+		BeforeEach(func() {
+			a = NewMockAPI(WithProtection(api.ProtectionPolicy{
+				Rules:          []api.ProtectionRule{api.ProtectTagged("protected")},
+				ProtectUpdates: true,
+			})).(*mockAPI)
+		})
+
+		It("denies destroying protected objects", func() {
+			id := a.FakeExisting(&testObject{}, "protected")
+			err := a.Destroy(context.TODO(), &testObject{Identifier: id})
+			Expect(api.IsProtectedResource(err)).To(BeTrue())
+			Expect(a.Inspect(id).existing).To(BeTrue())
+		})
+
+		It("denies updating protected objects", func() {
+			id := a.FakeExisting(&testObject{TestFieldA: "some text A"}, "protected")
+			err := a.Update(context.TODO(), &testObject{Identifier: id, TestFieldA: "updated text A"})
+			Expect(api.IsProtectedResource(err)).To(BeTrue())
+			Expect(a.Existing().Unwrap()[id]).To(Equal(&testObject{Identifier: id, TestFieldA: "some text A"}))
+		})
+
+		It("allows operations on protected objects with api.Force", func() {
+			id := a.FakeExisting(&testObject{}, "protected")
+			Expect(a.Update(context.TODO(), &testObject{Identifier: id, TestFieldA: "updated text A"}, api.Force())).To(Succeed())
+			Expect(a.Destroy(context.TODO(), &testObject{Identifier: id}, api.Force())).To(Succeed())
+		})
+
+		It("allows destroying unprotected objects", func() {
+			id := a.FakeExisting(&testObject{}, "other")
+			Expect(a.Destroy(context.TODO(), &testObject{Identifier: id})).To(Succeed())
+		})
+	})
 })
@@ -2,6 +2,8 @@ package mock
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 
 	"go.anx.io/go-anxcloud/pkg/api/types"
 )
@@ -47,11 +49,41 @@ func (i *mockPageIter) Next(objects interface{}) bool {
 	sliceFrom := uintMin((i.CurrentPage()-1)*i.ItemsPerPage(), i.TotalItems())
 	sliceTo := uintMin(i.CurrentPage()*i.ItemsPerPage(), i.TotalItems())
 
-	*objects.(*[]types.Object) = i.items[sliceFrom:sliceTo]
+	if err := setPage(objects, i.items[sliceFrom:sliceTo]); err != nil {
+		i.err = err
+		return false
+	}
+
 	i.page++
 	return true
 }
 
+// setPage sets the given pointer to []types.Object or []T, with *T being the type of the items, to the items.
+func setPage(objects interface{}, items []types.Object) error {
+	if o, ok := objects.(*[]types.Object); ok {
+		*o = items
+		return nil
+	}
+
+	val := reflect.ValueOf(objects)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w: expected *[]types.Object or *[]T, got %T", types.ErrTypeNotSupported, objects)
+	}
+
+	page := reflect.MakeSlice(val.Elem().Type(), len(items), len(items))
+	for n, item := range items {
+		v := reflect.ValueOf(item)
+		if v.Type() != reflect.PointerTo(page.Type().Elem()) {
+			return fmt.Errorf("%w: cannot store %T in %T", types.ErrTypeNotSupported, item, objects)
+		}
+
+		page.Index(n).Set(v.Elem())
+	}
+
+	val.Elem().Set(page)
+	return nil
+}
+
 // Returns error.
 func (i *mockPageIter) Error() error {
 	return i.err
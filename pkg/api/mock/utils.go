@@ -1,11 +1,15 @@
 package mock
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/mitchellh/copystructure"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/internal"
 	"go.anx.io/go-anxcloud/pkg/api/types"
 	"go.anx.io/go-anxcloud/pkg/utils/test"
 )
@@ -41,6 +45,50 @@ func merge(dest, src interface{}) (interface{}, error) {
 	return destClone, nil
 }
 
+// mergePartial applies the JSON fields of modified changed compared to original to a copy of dest, like the
+// generic API does for updates with the PartialUpdate option. api.ErrObjectModified is returned when dest was
+// changed compared to original.
+func mergePartial(dest, original, modified interface{}) (interface{}, error) {
+	if reflect.TypeOf(dest) != reflect.TypeOf(original) || reflect.TypeOf(dest) != reflect.TypeOf(modified) {
+		return nil, ErrMergeTypeMissmatch
+	}
+
+	fields := make([]map[string]json.RawMessage, 0, 3)
+	for _, o := range []interface{}{dest, original, modified} {
+		data, err := json.Marshal(o)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := internal.JSONFields(data)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, f)
+	}
+
+	if changed := internal.ModifiedFields(fields[1], fields[0]); len(changed) > 0 {
+		return nil, fmt.Errorf("%w: changed fields %v", api.ErrObjectModified, strings.Join(changed, ", "))
+	}
+
+	destClone, err := copystructure.Copy(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := json.Marshal(internal.ChangedFields(fields[1], fields[2]))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(changes, destClone); err != nil {
+		return nil, err
+	}
+
+	return destClone, nil
+}
+
 func _mergeStructs(dest, src reflect.Value, t reflect.Type) {
 	for i := 0; i < dest.NumField(); i++ {
 		if (t.Field(i).Type.Kind() == reflect.Ptr && src.Field(i).IsNil()) ||
@@ -60,22 +108,17 @@ func _mergeStructs(dest, src reflect.Value, t reflect.Type) {
 func makeObjectIdentifiable(o types.Object) string {
 	identifier := test.RandomIdentifier()
 	v := reflect.Indirect(reflect.ValueOf(o))
-	t := reflect.TypeOf(o).Elem()
 
-	if t.Kind() != reflect.Struct {
+	if v.Kind() != reflect.Struct {
 		return identifier
 	}
 
-	for i := 0; i < t.NumField(); i++ {
-		if tag, ok := t.Field(i).Tag.Lookup("anxcloud"); ok &&
-			tag == "identifier" &&
-			t.Field(i).Type.Kind() == reflect.String {
-			if v.Field(i).String() == "" {
-				v.Field(i).SetString(identifier)
-			} else {
-				// might be already set (DNS Zone has zone-name as identifier)
-				identifier = v.Field(i).String()
-			}
+	if field, ok := types.IdentifierField(v); ok && field.Kind() == reflect.String {
+		if field.String() == "" {
+			field.SetString(identifier)
+		} else {
+			// might be already set (DNS Zone has zone-name as identifier)
+			identifier = field.String()
 		}
 	}
 	return identifier
@@ -38,6 +38,23 @@ func AutoTag(tags ...string) CreateOption {
 	return internal.AutoTagOption(tags)
 }
 
+// ManagedTags can be used to sync the tags of objects after updating them: missing tags are added
+// and tags not given are removed. Passing no tags at all removes every tag from the object.
+func ManagedTags(tags ...string) UpdateOption {
+	return internal.ManagedTagsOption(tags)
+}
+
+// PartialUpdate makes Update only send the fields changed compared to original, the object as it was retrieved
+// before modifying it. Objects implementing types.PatchHook are updated with a PATCH request containing only the
+// changed fields, all others with a PUT request containing the changed fields merged into the current object
+// retrieved from the engine.
+//
+// The update is aborted with ErrObjectModified when a field was changed, set or cleared on the engine since
+// original was retrieved, no request is made when no field was changed.
+func PartialUpdate(original types.IdentifiedObject) UpdateOption {
+	return internal.PartialUpdateOption{Original: original}
+}
+
 // EnvironmentOption can be used to configure an alternative environment path
 // segment for a given API group
 func EnvironmentOption(apiGroup, envPathSegment string, override bool) types.AnyOption {
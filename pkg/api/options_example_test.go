@@ -10,13 +10,17 @@ import (
 
 	"go.anx.io/go-anxcloud/pkg/api"
 	"go.anx.io/go-anxcloud/pkg/api/mock"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
 	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
 )
 
 func ExampleAutoTag() {
 	a := mock.NewMockAPI()
 
-	vlan := vlanv1.VLAN{DescriptionCustomer: "mocked VLAN"}
+	vlan := vlanv1.VLAN{
+		DescriptionCustomer: "mocked VLAN",
+		Locations:           []corev1.Location{{Identifier: "location-identifier"}},
+	}
 	if err := a.Create(context.TODO(), &vlan, api.AutoTag("foo", "bar", "baz")); err != nil {
 		var taggingErr *api.ErrTaggingFailed
 		if errors.As(err, &taggingErr) {
@@ -33,3 +37,28 @@ func ExampleAutoTag() {
 	fmt.Println(strings.Join(tags, ", "))
 	// Output: bar, baz, foo
 }
+
+func ExampleManagedTags() {
+	a := mock.NewMockAPI()
+
+	vlan := vlanv1.VLAN{
+		DescriptionCustomer: "mocked VLAN",
+		Locations:           []corev1.Location{{Identifier: "location-identifier"}},
+	}
+	if err := a.Create(context.TODO(), &vlan, api.AutoTag("foo", "bar")); err != nil {
+		log.Fatalf("failed creating VLAN: %s", err)
+	}
+
+	// "bar" is kept, "baz" added and "foo" removed
+	vlan.DescriptionCustomer = "updated VLAN"
+	if err := a.Update(context.TODO(), &vlan, api.ManagedTags("bar", "baz")); err != nil {
+		log.Fatalf("failed updating VLAN: %s", err)
+	}
+
+	// Note that `a.Inspect` is only available when using the mock client implementation.
+	tags := a.Inspect(vlan.Identifier).Tags()
+	sort.Strings(tags)
+
+	fmt.Println(strings.Join(tags, ", "))
+	// Output: bar, baz
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mitchellh/copystructure"
+
+	"go.anx.io/go-anxcloud/pkg/api/internal"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// partialUpdateBody is the already encoded body of a partial update, sent with the given method.
+type partialUpdateBody struct {
+	method string
+	data   []byte
+}
+
+// partialUpdate sends the fields of o changed compared to options.Original, after checking the object on the
+// engine was not modified since the original was retrieved.
+func (a defaultAPI) partialUpdate(ctx context.Context, o types.IdentifiedObject, options types.UpdateOptions) error {
+	original := options.Original
+
+	if reflect.TypeOf(original) != reflect.TypeOf(o) {
+		return fmt.Errorf("original object for partial update is a %T, expected %T", original, o)
+	}
+
+	identifier, err := types.GetObjectIdentifier(o, true)
+	if err != nil {
+		return err
+	}
+
+	if originalIdentifier, err := types.GetObjectIdentifier(original, true); err != nil {
+		return err
+	} else if originalIdentifier != identifier {
+		return fmt.Errorf("original object for partial update has identifier %q, expected %q", originalIdentifier, identifier)
+	}
+
+	// copying the original keeps the fields the engine does not return, making them compare equal
+	cloned, err := copystructure.Copy(original)
+	if err != nil {
+		return fmt.Errorf("copy original object: %w", err)
+	}
+
+	current := cloned.(types.IdentifiedObject)
+	if err := a.Get(ctx, current); err != nil {
+		return fmt.Errorf("retrieve current object: %w", err)
+	}
+
+	requestCtx, err := a.contextPrepare(ctx, o, types.OperationUpdate, &options)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]map[string]json.RawMessage, 0, 3)
+	for _, obj := range []types.Object{original, current, o} {
+		data, err := encodeRequestBody(requestCtx, obj, obj)
+		if err != nil {
+			return err
+		}
+
+		f, err := internal.JSONFields(data)
+		if err != nil {
+			return err
+		}
+
+		fields = append(fields, f)
+	}
+
+	originalFields, currentFields, modifiedFields := fields[0], fields[1], fields[2]
+
+	if modified := internal.ModifiedFields(originalFields, currentFields); len(modified) > 0 {
+		return fmt.Errorf("%w: changed fields %v", ErrObjectModified, strings.Join(modified, ", "))
+	}
+
+	changes := internal.ChangedFields(originalFields, modifiedFields)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	body := partialUpdateBody{method: "PUT"}
+	if patch, ok := o.(types.PatchHook); ok && patch.SupportsPatch(requestCtx) {
+		body.method = "PATCH"
+		body.data, err = json.Marshal(changes)
+	} else {
+		body.data, err = json.Marshal(internal.MergeFields(currentFields, changes))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	request, err := a.makeRequest(requestCtx, o, body, types.OperationUpdate)
+	if err != nil {
+		return err
+	}
+
+	return a.doRequest(request, o, o)
+}
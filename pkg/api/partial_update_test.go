@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+type patchTestBackend struct {
+	lbaasv1.Backend
+}
+
+func (b *patchTestBackend) SupportsPatch(context.Context) bool {
+	return true
+}
+
+var _ = Describe("partial updates", func() {
+	var server *ghttp.Server
+	var api API
+	var original lbaasv1.Backend
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		a, err := NewAPI(WithClientOptions(
+			client.IgnoreMissingToken(),
+			client.BaseURL(server.URL()),
+		))
+		Expect(err).NotTo(HaveOccurred())
+		api = a
+
+		original = lbaasv1.Backend{
+			Identifier:    "foo",
+			Name:          "backend-01",
+			HealthCheck:   "check-from-engine",
+			Mode:          lbaasv1.TCP,
+			ServerTimeout: 10,
+			LoadBalancer:  lbaasv1.LoadBalancer{Identifier: "lb-01"},
+		}
+	})
+
+	currentObject := func(name string, serverTimeout int) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/LBaaS/v1/backend.json/foo"),
+			ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+				"identifier":     "foo",
+				"name":           name,
+				"mode":           "tcp",
+				"server_timeout": serverTimeout,
+				"health_check":   "check-from-engine",
+				"load_balancer":  map[string]string{"identifier": "lb-01"},
+			}),
+		)
+	}
+
+	It("sends the changed fields merged into the current object with PUT", func() {
+		server.AppendHandlers(
+			currentObject("backend-01", 10),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/LBaaS/v1/backend.json/foo"),
+				ghttp.VerifyJSON(`{"identifier":"foo","name":"backend-02","mode":"tcp","server_timeout":10,"health_check":"check-from-engine","load_balancer":"lb-01","state":"0"}`),
+				ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo", "name": "backend-02"}),
+			),
+		)
+
+		modified := original
+		modified.Name = "backend-02"
+		Expect(api.Update(context.TODO(), &modified, PartialUpdate(&original))).To(Succeed())
+		Expect(modified.Name).To(Equal("backend-02"))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("sends only the changed fields with PATCH for objects supporting it", func() {
+		server.AppendHandlers(
+			currentObject("backend-01", 10),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PATCH", "/api/LBaaS/v1/backend.json/foo"),
+				ghttp.VerifyJSON(`{"name":"backend-02","server_timeout":null}`),
+				ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo", "name": "backend-02"}),
+			),
+		)
+
+		originalPatch := patchTestBackend{original}
+		modified := patchTestBackend{original}
+		modified.Name = "backend-02"
+		modified.ServerTimeout = 0
+		Expect(api.Update(context.TODO(), &modified, PartialUpdate(&originalPatch))).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("aborts when the object was modified on the engine", func() {
+		server.AppendHandlers(currentObject("backend-03", 20))
+
+		modified := original
+		modified.Name = "backend-02"
+
+		err := api.Update(context.TODO(), &modified, PartialUpdate(&original))
+		Expect(err).To(MatchError(ErrObjectModified))
+		Expect(err).To(MatchError(ContainSubstring("name, server_timeout")))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	DescribeTable("aborts when a field was changed from or to null on the engine",
+		func(originalTimeout, currentTimeout int) {
+			server.AppendHandlers(currentObject("backend-01", currentTimeout))
+
+			original.ServerTimeout = originalTimeout
+			modified := original
+			modified.Name = "backend-02"
+
+			err := api.Update(context.TODO(), &modified, PartialUpdate(&original))
+			Expect(err).To(MatchError(ErrObjectModified))
+			Expect(err).To(MatchError(ContainSubstring("fields server_timeout")))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		},
+		Entry("changed to null", 10, 0),
+		Entry("changed from null", 0, 10),
+	)
+
+	It("does not send a request when no field was changed", func() {
+		server.AppendHandlers(currentObject("backend-01", 10))
+
+		modified := original
+		Expect(api.Update(context.TODO(), &modified, PartialUpdate(&original))).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("rejects originals of other objects", func() {
+		other := original
+		other.Identifier = "bar"
+
+		modified := original
+		Expect(api.Update(context.TODO(), &modified, PartialUpdate(&other))).To(MatchError(ContainSubstring(`identifier "bar"`)))
+		Expect(api.Update(context.TODO(), &modified, PartialUpdate(&lbaasv1.Frontend{Identifier: "foo"}))).To(MatchError(ContainSubstring("*v1.Frontend")))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,64 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	kubernetesv1 "go.anx.io/go-anxcloud/pkg/apis/kubernetes/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("client profiles", func() {
+	var (
+		srv        *ghttp.Server
+		configPath string
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		configPath = filepath.Join(GinkgoT().TempDir(), "config.json")
+		Expect(os.WriteFile(configPath, []byte(`{
+			"profiles": {
+				"staging": {
+					"token": "staging-token",
+					"base_url": "`+srv.URL()+`",
+					"environments": { "kubernetes/v1": "kubernetes-stg" }
+				}
+			}
+		}`), 0o600)).To(Succeed())
+	})
+
+	It("applies the environments of the profile to requests", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/kubernetes-stg/v1/cluster.json/foo"),
+			ghttp.VerifyHeaderKV("Authorization", "Token staging-token"),
+			ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo"}),
+		))
+
+		a, err := api.NewAPI(api.WithClientOptions(client.FromProfileFile(configPath, "staging")))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(a.Get(context.TODO(), &kubernetesv1.Cluster{Identifier: "foo"})).To(Succeed())
+	})
+
+	It("allows overriding the environments of the profile per request", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/kubernetes-dev/v1/cluster.json/foo"),
+			ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo"}),
+		))
+
+		a, err := api.NewAPI(api.WithClientOptions(client.FromProfileFile(configPath, "staging")))
+		Expect(err).NotTo(HaveOccurred())
+
+		err = a.Get(context.TODO(), &kubernetesv1.Cluster{Identifier: "foo"}, api.EnvironmentOption("kubernetes/v1", "kubernetes-dev", true))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	corev1helper "go.anx.io/go-anxcloud/pkg/apis/core/v1/helper"
+)
+
+const (
+	// forceKey is the key of the request option set by Force.
+	forceKey = "protection/force"
+)
+
+type forceContextKey struct{}
+
+// ErrProtectedResource is returned by Destroy operations, and Update operations if configured, on Objects
+// protected by a ProtectionPolicy, unless the Force option is passed.
+type ErrProtectedResource struct {
+	Operation types.Operation
+	Object    types.Object
+
+	// Reason is the reason given by the ProtectionRule matching the Object.
+	Reason string
+}
+
+// Error returns the error message.
+func (e ErrProtectedResource) Error() string {
+	identifier, _ := types.GetObjectIdentifier(e.Object, false)
+	return fmt.Sprintf("%v of protected %v %q denied (%v), pass api.Force() to override", e.Operation, types.ObjectTypeName(e.Object), identifier, e.Reason)
+}
+
+// IsProtectedResource returns if the given error is or wraps an ErrProtectedResource.
+func IsProtectedResource(err error) bool {
+	var protected *ErrProtectedResource
+	return errors.As(err, &protected)
+}
+
+// Force makes Destroy and Update operations on Objects protected by a ProtectionPolicy succeed. Operations made
+// while handling the forced operation, like syncing the tags for ManagedTags, are forced, too.
+func Force() types.AnyOption {
+	return func(o types.Options) error {
+		return o.Set(forceKey, true, true)
+	}
+}
+
+// ProtectionExemption is an interface Objects can implement to opt out of ProtectionPolicy checks, for Objects
+// not being resources themselves, like the association of a tag to a resource.
+type ProtectionExemption interface {
+	// ProtectionExempt returns if operations on the Object are never denied by a ProtectionPolicy.
+	ProtectionExempt() bool
+}
+
+// ProtectionRule decides if an Object is protected, returning the reason if it is and an empty string otherwise.
+// It is called with the Object as passed to the operation, the given API can be used to retrieve further
+// information about it.
+type ProtectionRule func(ctx context.Context, a types.API, o types.IdentifiedObject) (string, error)
+
+// ProtectType protects all Objects of the same type as the given one.
+func ProtectType(o types.Object) ProtectionRule {
+	t := reflect.TypeOf(o)
+
+	return func(_ context.Context, _ types.API, o types.IdentifiedObject) (string, error) {
+		if reflect.TypeOf(o) == t {
+			return fmt.Sprintf("type %v is protected", types.ObjectTypeName(o)), nil
+		}
+
+		return "", nil
+	}
+}
+
+// ProtectIdentifiers protects the Objects with the given identifiers.
+func ProtectIdentifiers(identifiers ...string) ProtectionRule {
+	return func(_ context.Context, _ types.API, o types.IdentifiedObject) (string, error) {
+		identifier, err := types.GetObjectIdentifier(o, false)
+		if err != nil {
+			return "", err
+		}
+
+		for _, protected := range identifiers {
+			if identifier == protected {
+				return fmt.Sprintf("identifier %q is protected", identifier), nil
+			}
+		}
+
+		return "", nil
+	}
+}
+
+// ProtectNames protects the Objects with a Name field matching the given pattern, with the syntax of path.Match.
+// The pattern is checked when the ProtectionPolicy is used, returning path.ErrBadPattern if it is malformed.
+func ProtectNames(pattern string) ProtectionRule {
+	return func(_ context.Context, _ types.API, o types.IdentifiedObject) (string, error) {
+		v := reflect.ValueOf(o)
+		for v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			return "", nil
+		}
+
+		name := v.FieldByName("Name")
+		if !name.IsValid() || name.Kind() != reflect.String {
+			return "", nil
+		}
+
+		if matched, err := path.Match(pattern, name.String()); err != nil {
+			return "", err
+		} else if matched {
+			return fmt.Sprintf("name %q matches %q", name.String(), pattern), nil
+		}
+
+		return "", nil
+	}
+}
+
+// ProtectTagged protects the Objects tagged with any of the given tags, retrieving the tags of the Object like
+// corev1.ListTags. This makes an additional request for every protected operation and requires the package
+// pkg/apis/core/v1 to be imported.
+func ProtectTagged(tags ...string) ProtectionRule {
+	return func(ctx context.Context, a types.API, o types.IdentifiedObject) (string, error) {
+		if corev1helper.TaggerImplementation == nil {
+			return "", errors.New("checking tags requires pkg/apis/core/v1 to be imported")
+		}
+
+		objectTags, err := corev1helper.TaggerImplementation.ListTags(ctx, a, o)
+		if err != nil {
+			return "", fmt.Errorf("list tags: %w", err)
+		}
+
+		for _, tag := range objectTags {
+			for _, protected := range tags {
+				if tag == protected {
+					return fmt.Sprintf("tagged %q", tag), nil
+				}
+			}
+		}
+
+		return "", nil
+	}
+}
+
+// ProtectionPolicy configures the Objects protected from Destroy, and optionally Update, operations without the
+// Force option. Use WithProtection to apply it to an API.
+type ProtectionPolicy struct {
+	// Rules deciding if an Object is protected, an Object matching any rule is protected.
+	Rules []ProtectionRule
+
+	// ProtectUpdates makes Update operations on protected Objects fail, too.
+	ProtectUpdates bool
+}
+
+// WithProtection configures the API to make Destroy operations, and Update operations if configured, on Objects
+// protected by the given policy fail with ErrProtectedResource, unless the Force option is passed. It is
+// implemented as Interceptor, running after Interceptors configured before.
+func WithProtection(policy ProtectionPolicy) NewAPIOption {
+	return WithInterceptors(policy.Interceptor())
+}
+
+// Interceptor returns an Interceptor checking the operations with the policy.
+func (p ProtectionPolicy) Interceptor() Interceptor {
+	return func(ctx context.Context, inv *Invocation, next Invoker) error {
+		ctx, err := p.Check(ctx, inv.API, inv.Operation, inv.Object, inv.Options)
+		if err != nil {
+			return err
+		}
+
+		return next(ctx)
+	}
+}
+
+// Check returns an *ErrProtectedResource if the given operation on the Object is denied by the policy. Forced
+// operations are marked in the returned context, making operations made with it forced, too. Objects implementing
+// ProtectionExemption are not checked.
+func (p ProtectionPolicy) Check(ctx context.Context, a types.API, op types.Operation, o types.Object, opts types.Options) (context.Context, error) {
+	if forced, _ := ctx.Value(forceContextKey{}).(bool); forced {
+		return ctx, nil
+	}
+
+	if forced, err := opts.Get(forceKey); err == nil && forced == true {
+		return context.WithValue(ctx, forceContextKey{}, true), nil
+	}
+
+	if op != types.OperationDestroy && (op != types.OperationUpdate || !p.ProtectUpdates) {
+		return ctx, nil
+	}
+
+	if exemption, ok := o.(ProtectionExemption); ok && exemption.ProtectionExempt() {
+		return ctx, nil
+	}
+
+	for _, rule := range p.Rules {
+		reason, err := rule(ctx, a, o)
+		if err != nil {
+			return ctx, fmt.Errorf("check protection of object: %w", err)
+		} else if reason != "" {
+			return ctx, &ErrProtectedResource{Operation: op, Object: o, Reason: reason}
+		}
+	}
+
+	return ctx, nil
+}
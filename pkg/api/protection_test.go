@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	corev1helper "go.anx.io/go-anxcloud/pkg/apis/core/v1/helper"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+)
+
+// protectionTestTagger returns the tags of Objects by identifier.
+type protectionTestTagger struct {
+	corev1helper.Tagger
+
+	tags map[string][]string
+}
+
+func (t protectionTestTagger) ListTags(_ context.Context, _ types.API, o types.IdentifiedObject) ([]string, error) {
+	identifier, err := types.GetObjectIdentifier(o, true)
+	return t.tags[identifier], err
+}
+
+// protectionTestTag is the association of a tag to the Object with the same identifier, like corev1.ResourceWithTag.
+type protectionTestTag struct {
+	Identifier string `json:"identifier" anxcloud:"identifier"`
+	Tag        string `json:"tag"`
+}
+
+func (t *protectionTestTag) EndpointURL(context.Context) (*url.URL, error) {
+	return url.Parse("/protection-test/tags")
+}
+
+func (t *protectionTestTag) GetIdentifier(context.Context) (string, error) {
+	return t.Identifier, nil
+}
+
+func (t *protectionTestTag) ProtectionExempt() bool {
+	return true
+}
+
+// protectionTestSyncer removes all tags of Objects by destroying their protectionTestTag.
+type protectionTestSyncer struct {
+	corev1helper.Tagger
+}
+
+func (protectionTestSyncer) SyncTags(ctx context.Context, a types.API, o types.IdentifiedObject, _ ...string) error {
+	identifier, err := types.GetObjectIdentifier(o, true)
+	if err != nil {
+		return err
+	}
+
+	return a.Destroy(ctx, &protectionTestTag{Identifier: identifier})
+}
+
+var _ = Describe("WithProtection", func() {
+	var engine *testEngine
+	var a types.API
+	var policy ProtectionPolicy
+	var acl lbaasv1.ACL
+
+	BeforeEach(func() {
+		policy = ProtectionPolicy{}
+
+		engine = startTestEngine(nil)
+	})
+
+	JustBeforeEach(func() {
+		a = engine.newAPI(WithProtection(policy))
+
+		acl = lbaasv1.ACL{Name: "prod-acl", ParentType: "frontend"}
+		Expect(a.Create(context.TODO(), &acl)).To(Succeed())
+	})
+
+	expectProtected := func(err error, reason string) {
+		var protected *ErrProtectedResource
+		Expect(errors.As(err, &protected)).To(BeTrue())
+		Expect(protected.Operation).To(Equal(types.OperationDestroy))
+		Expect(protected.Object).To(BeIdenticalTo(&acl))
+		Expect(protected.Reason).To(Equal(reason))
+
+		Expect(IsProtectedResource(err)).To(BeTrue())
+		Expect(engine.Objects(&lbaasv1.ACL{})).To(HaveLen(1))
+	}
+
+	Context("protecting a type", func() {
+		BeforeEach(func() {
+			policy.Rules = []ProtectionRule{ProtectType(&lbaasv1.ACL{})}
+		})
+
+		It("denies Destroy", func() {
+			err := a.Destroy(context.TODO(), &acl)
+			expectProtected(err, "type lbaas/v1.ACL is protected")
+			Expect(err.Error()).To(Equal(`Destroy of protected lbaas/v1.ACL "` + acl.Identifier + `" denied (type lbaas/v1.ACL is protected), pass api.Force() to override`))
+		})
+
+		It("allows Update when updates are not protected", func() {
+			acl.Name = "updated"
+			Expect(a.Update(context.TODO(), &acl)).To(Succeed())
+		})
+
+		It("allows Destroy with Force", func() {
+			Expect(a.Destroy(context.TODO(), &acl, Force())).To(Succeed())
+			Expect(engine.Objects(&lbaasv1.ACL{})).To(BeEmpty())
+		})
+	})
+
+	Context("protecting updates", func() {
+		BeforeEach(func() {
+			policy.Rules = []ProtectionRule{ProtectIdentifiers("not-this", "not-that"), ProtectNames("prod-*")}
+			policy.ProtectUpdates = true
+		})
+
+		It("denies Update", func() {
+			acl.ParentType = "backend"
+			err := a.Update(context.TODO(), &acl)
+
+			var protected *ErrProtectedResource
+			Expect(errors.As(err, &protected)).To(BeTrue())
+			Expect(protected.Operation).To(Equal(types.OperationUpdate))
+			Expect(protected.Reason).To(Equal(`name "prod-acl" matches "prod-*"`))
+
+			Expect(a.Update(context.TODO(), &acl, Force())).To(Succeed())
+		})
+	})
+
+	Context("protecting identifiers", func() {
+		It("denies Destroy", func() {
+			a := engine.newAPI(WithProtection(ProtectionPolicy{Rules: []ProtectionRule{ProtectIdentifiers(acl.Identifier)}}))
+
+			expectProtected(a.Destroy(context.TODO(), &acl), `identifier "`+acl.Identifier+`" is protected`)
+		})
+
+		It("allows removing tags with ManagedTags on Update", func() {
+			Expect(engine.Register(&protectionTestTag{})).To(Succeed())
+
+			previous := corev1helper.TaggerImplementation
+			corev1helper.TaggerImplementation = protectionTestSyncer{}
+			DeferCleanup(func() { corev1helper.TaggerImplementation = previous })
+
+			a := engine.newAPI(WithProtection(ProtectionPolicy{Rules: []ProtectionRule{ProtectIdentifiers(acl.Identifier)}}))
+			Expect(a.Create(context.TODO(), &protectionTestTag{Identifier: acl.Identifier, Tag: "foo"})).To(Succeed())
+
+			acl.Name = "updated"
+			Expect(a.Update(context.TODO(), &acl, ManagedTags("bar"))).To(Succeed())
+			Expect(engine.Objects(&protectionTestTag{})).To(BeEmpty())
+		})
+	})
+
+	Context("protecting names", func() {
+		BeforeEach(func() {
+			policy.Rules = []ProtectionRule{ProtectNames("prod-*")}
+		})
+
+		It("denies Destroy of matching objects", func() {
+			expectProtected(a.Destroy(context.TODO(), &acl), `name "prod-acl" matches "prod-*"`)
+		})
+
+		It("allows Destroy of other objects", func() {
+			other := lbaasv1.ACL{Name: "dev-acl", ParentType: "frontend"}
+			Expect(a.Create(context.TODO(), &other)).To(Succeed())
+			Expect(a.Destroy(context.TODO(), &other)).To(Succeed())
+		})
+
+		Context("with a malformed pattern", func() {
+			BeforeEach(func() {
+				policy.Rules = []ProtectionRule{ProtectNames("prod-[")}
+			})
+
+			It("returns the error", func() {
+				Expect(a.Destroy(context.TODO(), &acl)).To(MatchError(path.ErrBadPattern))
+			})
+		})
+	})
+
+	Context("protecting tagged objects", func() {
+		var tagger protectionTestTagger
+
+		BeforeEach(func() {
+			policy.Rules = []ProtectionRule{ProtectTagged("protected")}
+
+			tagger = protectionTestTagger{tags: make(map[string][]string)}
+
+			previous := corev1helper.TaggerImplementation
+			corev1helper.TaggerImplementation = tagger
+			DeferCleanup(func() { corev1helper.TaggerImplementation = previous })
+		})
+
+		It("denies Destroy of tagged objects", func() {
+			tagger.tags[acl.Identifier] = []string{"foo", "protected"}
+			expectProtected(a.Destroy(context.TODO(), &acl), `tagged "protected"`)
+		})
+
+		It("allows Destroy of untagged objects", func() {
+			tagger.tags[acl.Identifier] = []string{"foo"}
+			Expect(a.Destroy(context.TODO(), &acl)).To(Succeed())
+		})
+	})
+})
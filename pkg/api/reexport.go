@@ -18,3 +18,39 @@ type UpdateOption = types.UpdateOption
 
 // DestroyOption is the interface options have to implement to be usable with Destroy operation. Re-exported from pkg/api/types.
 type DestroyOption = types.DestroyOption
+
+// ErrUnknownResourceType is returned when no Object is registered for an Engine resource type. Re-exported from pkg/api/types.
+var ErrUnknownResourceType = types.ErrUnknownResourceType
+
+// ResourceFactory returns a new Object for an Engine resource with its identifying field set. Re-exported from pkg/api/types.
+type ResourceFactory = types.ResourceFactory
+
+// RegisterResourceType registers the Object type for an Engine resource type. Re-exported from pkg/api/types.
+func RegisterResourceType(typeIdentifier string, factory ResourceFactory) {
+	types.RegisterResourceType(typeIdentifier, factory)
+}
+
+// NewObjectForResourceType returns a new Object for a resource of a registered Engine resource type. Re-exported from pkg/api/types.
+func NewObjectForResourceType(typeIdentifier, identifier, name string) (types.Object, error) {
+	return types.NewObjectForResourceType(typeIdentifier, identifier, name)
+}
+
+// RegisterDiscoverableResource registers the Object type for an Engine resource type with unknown identifier. Re-exported from pkg/api/types.
+func RegisterDiscoverableResource(factory ResourceFactory) {
+	types.RegisterDiscoverableResource(factory)
+}
+
+// DiscoverableResourceObjects returns new Objects of the types registered for discovery. Re-exported from pkg/api/types.
+func DiscoverableResourceObjects(identifier, name string) []types.Object {
+	return types.DiscoverableResourceObjects(identifier, name)
+}
+
+// RegisterDiscoveredResourceType registers a discovered Object type for an Engine resource type. Re-exported from pkg/api/types.
+func RegisterDiscoveredResourceType(typeIdentifier string, o types.Object) {
+	types.RegisterDiscoveredResourceType(typeIdentifier, o)
+}
+
+// ResourceTypeOf returns the Engine resource type registered for the type of the given Object. Re-exported from pkg/api/types.
+func ResourceTypeOf(o types.Object) (string, bool) {
+	return types.ResourceTypeOf(o)
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
+	"go.anx.io/go-anxcloud/pkg/utils/retry"
+)
+
+// TracerName is the name of the OpenTelemetry tracer used for spans created by the generic API.
+const TracerName = "go.anx.io/go-anxcloud/pkg/api"
+
+// Attributes set on the spans created for operations.
+const (
+	AttributeOperation    = attribute.Key("anxcloud.operation")
+	AttributeResourceType = attribute.Key("anxcloud.resource.type")
+	AttributeIdentifier   = attribute.Key("anxcloud.resource.identifier")
+	AttributeRetryCount   = attribute.Key("anxcloud.retry.count")
+)
+
+// WithTracing enables tracing with the given OpenTelemetry TracerProvider, creating a span for every operation
+// with spans for the requests made for it as children, including requests for further pages and FullObjects.
+// The client is configured to trace requests and propagate the trace context, too. Passing nil uses the global
+// TracerProvider.
+func WithTracing(tp trace.TracerProvider) NewAPIOption {
+	return func(a *defaultAPI) {
+		if tp == nil {
+			tp = otel.GetTracerProvider()
+		}
+
+		a.tracer = tp.Tracer(TracerName)
+		a.clientOptions = append(a.clientOptions, client.WithTracerProvider(tp))
+	}
+}
+
+// startOperationSpan starts a span for the given operation if tracing is enabled. The returned function ends
+// the span, recording the given error.
+func (a defaultAPI) startOperationSpan(ctx context.Context, op types.Operation, o types.Object) (context.Context, func(error)) {
+	if a.tracer == nil || ctx == nil || o == nil {
+		return ctx, func(error) {}
+	}
+
+	resourceType := types.ObjectTypeName(o)
+
+	attributes := []attribute.KeyValue{
+		AttributeOperation.String(string(op)),
+		AttributeResourceType.String(resourceType),
+	}
+
+	if attempt := retry.AttemptFromContext(ctx); attempt > 0 {
+		attributes = append(attributes, AttributeRetryCount.Int(attempt))
+	}
+
+	ctx, span := a.tracer.Start(ctx, string(op)+" "+resourceType,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx, func(err error) {
+		// identifiers of created objects are only known after the operation
+		if identifier, idErr := types.GetObjectIdentifier(o, false); idErr == nil && identifier != "" {
+			span.SetAttributes(AttributeIdentifier.String(identifier))
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		span.End()
+	}
+}
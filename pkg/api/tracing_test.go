@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("tracing", func() {
+	var (
+		srv      *ghttp.Server
+		recorder *tracetest.SpanRecorder
+		a        API
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		recorder = tracetest.NewSpanRecorder()
+
+		var err error
+		a, err = NewAPI(
+			WithClientOptions(
+				client.BaseURL(srv.URL()),
+				client.IgnoreMissingToken(),
+			),
+			WithTracing(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	spanAttribute := func(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+		for _, kv := range span.Attributes() {
+			if kv.Key == key {
+				return kv.Value
+			}
+		}
+		return attribute.Value{}
+	}
+
+	It("creates a span per operation with the request spans as children", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/resource/v1/identifier"),
+			ghttp.RespondWithJSONEncoded(200, map[string]string{"value": "identifier"}),
+		))
+
+		Expect(a.Get(context.TODO(), &apiTestObject{"identifier"})).To(Succeed())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+
+		httpSpan, operationSpan := spans[0], spans[1]
+		Expect(operationSpan.Name()).To(Equal("Get go.anx.io/go-anxcloud/pkg/api.apiTestObject"))
+		Expect(spanAttribute(operationSpan, AttributeOperation).AsString()).To(Equal("Get"))
+		Expect(spanAttribute(operationSpan, AttributeResourceType).AsString()).To(Equal("go.anx.io/go-anxcloud/pkg/api.apiTestObject"))
+		Expect(spanAttribute(operationSpan, AttributeIdentifier).AsString()).To(Equal("identifier"))
+
+		Expect(httpSpan.Parent().SpanID()).To(Equal(operationSpan.SpanContext().SpanID()))
+		Expect(spanAttribute(httpSpan, client.AttributeHTTPStatusCode).AsInt64()).To(BeEquivalentTo(200))
+	})
+
+	It("records errors on the operation span", func() {
+		srv.AppendHandlers(ghttp.RespondWith(404, "{}"))
+
+		err := a.Destroy(context.TODO(), &apiTestObject{"identifier"})
+		Expect(err).To(MatchError(ErrNotFound))
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[1].Name()).To(Equal("Destroy go.anx.io/go-anxcloud/pkg/api.apiTestObject"))
+		Expect(spans[1].Status().Code).To(Equal(codes.Error))
+	})
+
+	It("traces page fetches and FullObjects gets of List operations", func() {
+		srv.RouteToHandler("GET", "/resource/v1", func(w http.ResponseWriter, r *http.Request) {
+			data := []map[string]string{}
+			if r.URL.Query().Get("page") == "1" {
+				data = append(data, map[string]string{"value": "identifier"})
+			}
+
+			ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+				"page":        1,
+				"total_pages": 1,
+				"total_items": 1,
+				"limit":       10,
+				"data":        data,
+			})(w, r)
+		})
+		srv.RouteToHandler("GET", "/resource/v1/identifier", ghttp.RespondWithJSONEncoded(200, map[string]string{"value": "identifier"}))
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		var oc types.ObjectChannel
+		Expect(a.List(ctx, &apiTestObject{}, ObjectChannel(&oc), FullObjects(true))).To(Succeed())
+
+		count := 0
+		for retriever := range oc {
+			var o apiTestObject
+			Expect(retriever(&o)).To(Succeed())
+			count++
+		}
+		Expect(count).To(Equal(1))
+
+		var listSpan sdktrace.ReadOnlySpan
+		for _, span := range recorder.Ended() {
+			if span.Name() == "List go.anx.io/go-anxcloud/pkg/api.apiTestObject" {
+				listSpan = span
+			}
+		}
+		Expect(listSpan).NotTo(BeNil())
+
+		var children []string
+		for _, span := range recorder.Ended() {
+			if span.Parent().SpanID() == listSpan.SpanContext().SpanID() {
+				children = append(children, span.Name())
+			}
+		}
+
+		// first page, FullObjects Get and empty second page
+		Expect(children).To(ConsistOf("GET", "Get go.anx.io/go-anxcloud/pkg/api.apiTestObject", "GET"))
+	})
+})
@@ -5,8 +5,13 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 )
 
+// apisPackagePrefix is trimmed from package paths to get readable Object type names, like "lbaas/v1.Rule".
+const apisPackagePrefix = "go.anx.io/go-anxcloud/pkg/apis/"
+
 // Object is the interface all objects to be retrieved by the generic API client are required to implement.
 //
 // On top of implementing this interface, an Object is always implemented as a struct, the pointer to it is what is passed to the generic API client.
@@ -68,6 +73,20 @@ type FilterRequestURLHook interface {
 	FilterRequestURL(ctx context.Context, url *url.URL) (*url.URL, error)
 }
 
+// ValidationHook is an interface Objects can optionally implement to be validated before they are sent to the engine
+// with Create and Update operations. The object-generator generates it from `validate` struct tags.
+type ValidationHook interface {
+	// Validate returns an error describing all invalid fields of the Object for the given operation.
+	Validate(ctx context.Context, op Operation) error
+}
+
+// PatchHook is an interface Objects can optionally implement when their engine endpoint accepts PATCH requests
+// containing only the changed fields, used by Update operations made with the PartialUpdate option.
+type PatchHook interface {
+	// SupportsPatch returns if partial updates of the Object can be sent as PATCH request.
+	SupportsPatch(ctx context.Context) bool
+}
+
 // GetObjectIdentifier extracts the identifier of the given object, returning an error if objects GetIdentifier
 // call fails or singleObjectOperation is true and an identifier field is found, but empty.
 func GetObjectIdentifier(obj Object, singleObjectOperation bool) (string, error) {
@@ -80,3 +99,42 @@ func GetObjectIdentifier(obj Object, singleObjectOperation bool) (string, error)
 
 	return id, nil
 }
+
+// ObjectTypeName returns the package-qualified name of the type of the given Object without pointers, like
+// "lbaas/v1.Rule". The package path is relative to pkg/apis for Objects defined there. It is used as resource
+// label of metrics and tracing spans and to order resources when cleaning them up.
+func ObjectTypeName(o Object) string {
+	t := reflect.TypeOf(o)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Name() == "" {
+		return t.String()
+	}
+
+	return strings.TrimPrefix(t.PkgPath(), apisPackagePrefix) + "." + t.Name()
+}
+
+// IsIdentifierField returns if the given struct field is tagged `anxcloud:"identifier"`, optionally followed by
+// further comma-separated options.
+func IsIdentifierField(field reflect.StructField) bool {
+	tag, ok := field.Tag.Lookup("anxcloud")
+	return ok && strings.Split(tag, ",")[0] == "identifier"
+}
+
+// IdentifierField returns the exported field tagged `anxcloud:"identifier"` of the given struct, including fields
+// of embedded structs, and if one was found. Fields promoted through nil embedded pointers are skipped.
+func IdentifierField(v reflect.Value) (reflect.Value, bool) {
+	for _, field := range reflect.VisibleFields(v.Type()) {
+		if !field.IsExported() || !IsIdentifierField(field) {
+			continue
+		}
+
+		if f, err := v.FieldByIndexErr(field.Index); err == nil {
+			return f, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
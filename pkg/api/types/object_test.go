@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"reflect"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -109,3 +110,41 @@ var _ = Describe("GetObjectIdentifier function", func() {
 		})
 	})
 })
+
+var _ = Describe("ObjectTypeName function", func() {
+	It("returns the package-qualified type name without pointers", func() {
+		o := &apiTestObject{}
+		Expect(ObjectTypeName(o)).To(Equal("go.anx.io/go-anxcloud/pkg/api/types.apiTestObject"))
+	})
+})
+
+type apiTestOptionsIdentObject struct {
+	Name       string
+	Identifier string `anxcloud:"identifier,filterable"`
+}
+
+var _ = Describe("IdentifierField function", func() {
+	It("returns identifier fields tagged with further options", func() {
+		o := apiTestOptionsIdentObject{Identifier: "test"}
+		field, ok := IdentifierField(reflect.ValueOf(o))
+		Expect(ok).To(BeTrue())
+		Expect(field.String()).To(Equal("test"))
+	})
+
+	It("returns identifier fields of embedded structs", func() {
+		o := apiTestEmbeddedidentObject{apiTestObject{Val: "test"}}
+		field, ok := IdentifierField(reflect.ValueOf(o))
+		Expect(ok).To(BeTrue())
+		Expect(field.String()).To(Equal("test"))
+	})
+
+	It("skips identifier fields of nil embedded pointers", func() {
+		_, ok := IdentifierField(reflect.ValueOf(apiTestPtrembeddedidentObject{}))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("returns false for structs without identifier field", func() {
+		_, ok := IdentifierField(reflect.ValueOf(RandomEmbeddedData{}))
+		Expect(ok).To(BeFalse())
+	})
+})
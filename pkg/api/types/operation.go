@@ -51,6 +51,13 @@ type CreateOptions struct {
 // UpdateOptions contains options valid for Update operations.
 type UpdateOptions struct {
 	commonOptions
+
+	// ManagedTags, when not nil, are the tags the object should have after the update.
+	ManagedTags []string
+
+	// Original, when not nil, is the object as retrieved before it was modified, making the update only send the
+	// changed fields.
+	Original IdentifiedObject
 }
 
 // DestroyOptions contains options valid for Destroy operations.
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrUnknownResourceType is returned when no Object is registered for an Engine resource type.
+var ErrUnknownResourceType = errors.New("no object registered for resource type")
+
+// ResourceFactory returns a new Object for the Engine resource with the given identifier and name, with
+// the identifying field of the Object set.
+type ResourceFactory func(identifier, name string) Object
+
+var resourceTypes = struct {
+	sync.RWMutex
+
+	factories map[string]ResourceFactory
+	byObject  map[reflect.Type]string
+
+	// discoverable are the factories of Objects of resource types with unknown identifier, in registration order
+	discoverable []ResourceFactory
+}{
+	factories: make(map[string]ResourceFactory),
+	byObject:  make(map[reflect.Type]string),
+}
+
+// RegisterResourceType registers the factory for Objects representing resources of the Engine resource type
+// identified by typeIdentifier (corev1.Type.Identifier). This is usually called from generated code in the
+// init function of the packages in pkg/apis, for Objects marked with the resourcetype magic comment spec.
+// It panics if the resource type is registered twice.
+func RegisterResourceType(typeIdentifier string, factory ResourceFactory) {
+	resourceTypes.Lock()
+	defer resourceTypes.Unlock()
+
+	if _, ok := resourceTypes.factories[typeIdentifier]; ok {
+		panic(fmt.Sprintf("resource type %q registered twice", typeIdentifier))
+	}
+
+	resourceTypes.factories[typeIdentifier] = factory
+	resourceTypes.byObject[reflect.TypeOf(factory("", ""))] = typeIdentifier
+}
+
+// NewObjectForResourceType returns a new Object for the resource with the given identifier and name, of the
+// Engine resource type identified by typeIdentifier. Only types registered with RegisterResourceType are
+// known, which requires importing the package defining the Object. ErrUnknownResourceType is returned
+// for unknown resource types.
+func NewObjectForResourceType(typeIdentifier, identifier, name string) (Object, error) {
+	resourceTypes.RLock()
+	factory, ok := resourceTypes.factories[typeIdentifier]
+	resourceTypes.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownResourceType, typeIdentifier)
+	}
+
+	return factory(identifier, name), nil
+}
+
+// ResourceTypeOf returns the Engine resource type identifier registered for the type of the given Object.
+func ResourceTypeOf(o Object) (string, bool) {
+	resourceTypes.RLock()
+	defer resourceTypes.RUnlock()
+
+	typeIdentifier, ok := resourceTypes.byObject[reflect.TypeOf(o)]
+	return typeIdentifier, ok
+}
+
+// RegisterDiscoverableResource registers the factory for Objects representing resources of an Engine resource type
+// with unknown identifier. Resources of unknown resource types are resolved by retrieving them as each of these
+// Objects (see DiscoverableResourceObjects), the resource type of the first one found is registered for its Object
+// with RegisterDiscoveredResourceType. This is usually called from generated code in the init function of the
+// packages in pkg/apis, for Objects marked with the resourcediscovery magic comment spec.
+func RegisterDiscoverableResource(factory ResourceFactory) {
+	resourceTypes.Lock()
+	defer resourceTypes.Unlock()
+
+	resourceTypes.discoverable = append(resourceTypes.discoverable, factory)
+}
+
+// DiscoverableResourceObjects returns a new Object of every type registered with RegisterDiscoverableResource and not
+// yet discovered for a resource type, for the resource with the given identifier and name.
+func DiscoverableResourceObjects(identifier, name string) []Object {
+	resourceTypes.RLock()
+	defer resourceTypes.RUnlock()
+
+	objects := make([]Object, 0, len(resourceTypes.discoverable))
+	for _, factory := range resourceTypes.discoverable {
+		o := factory(identifier, name)
+		if _, ok := resourceTypes.byObject[reflect.TypeOf(o)]; !ok {
+			objects = append(objects, o)
+		}
+	}
+
+	return objects
+}
+
+// RegisterDiscoveredResourceType registers the Object type of the given Object, registered with
+// RegisterDiscoverableResource, for the Engine resource type identified by typeIdentifier. Nothing is registered if
+// the resource type or the Object type is registered already.
+func RegisterDiscoveredResourceType(typeIdentifier string, o Object) {
+	resourceTypes.Lock()
+	defer resourceTypes.Unlock()
+
+	objectType := reflect.TypeOf(o)
+	if _, ok := resourceTypes.factories[typeIdentifier]; ok {
+		return
+	} else if _, ok := resourceTypes.byObject[objectType]; ok {
+		return
+	}
+
+	for _, factory := range resourceTypes.discoverable {
+		if reflect.TypeOf(factory("", "")) == objectType {
+			resourceTypes.factories[typeIdentifier] = factory
+			resourceTypes.byObject[objectType] = typeIdentifier
+			return
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Package validation implements the checks used by the Validate methods the object-generator generates from
+// `validate` struct tags. Objects are validated by the generic API before they are sent to the engine with
+// Create and Update operations, returning an *Error describing all invalid fields.
+//
+// The `validate` tag contains a comma-separated list of rules, which are checked for Create and Update
+// operations, unless limited to one of them with the create or update keyword:
+//
+//	create          only check the rules for Create operations
+//	update          only check the rules for Update operations
+//	required        the field must not be empty (zero value, nil or empty string, slice or map), references to
+//	                other Objects (structs with a field tagged `anxcloud:"identifier"`) must have the identifier set
+//	enum=a|b|c      the value must be one of the given values
+//	min=N, max=N    the value (numbers) or length (strings, slices and maps) must be in the given range
+//	cidr            the value must be an IP network in CIDR notation
+//	hostname        the value must be a RFC 1123 hostname
+//	lowercase       the value must not contain uppercase characters
+//	err=ErrName     errors for the field wrap the package variable ErrName, checkable with errors.Is
+//	pattern=regex   the value must match the regular expression, has to be the last rule as it may contain commas
+//
+// Rules other than required and min are not checked for empty values, apply to every element of slices and
+// follow pointers, skipping nil pointers. Example:
+//
+//	Name string `json:"name" validate:"create,required,hostname,lowercase"`
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// ErrInvalidObject is matched by every *Error with errors.Is.
+var ErrInvalidObject = errors.New("invalid object")
+
+// FieldError describes why the value of a single field is invalid.
+type FieldError struct {
+	// Field is the name of the field in the Go struct.
+	Field string
+
+	// Message describes why the value is invalid.
+	Message string
+
+	// Err is the error configured with the err rule, if any.
+	Err error
+}
+
+// Error returns the field name and message, followed by the configured error.
+func (e FieldError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%v: %v (%v)", e.Field, e.Message, e.Err)
+	}
+
+	return fmt.Sprintf("%v: %v", e.Field, e.Message)
+}
+
+// Unwrap returns the error configured with the err rule, making it checkable with errors.Is.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Error is returned by Validate methods for invalid objects, containing an error for every invalid field.
+type Error struct {
+	// Object is the name of the type of the validated object.
+	Object string
+
+	// Operation is the operation the object was validated for.
+	Operation types.Operation
+
+	// Fields contains an error for every invalid field.
+	Fields []FieldError
+}
+
+// Error returns a message listing all invalid fields.
+func (e *Error) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.Error())
+	}
+
+	return fmt.Sprintf("%v %v for %v: %v", ErrInvalidObject, e.Object, e.Operation, strings.Join(fields, "; "))
+}
+
+// Is returns true for ErrInvalidObject.
+func (e *Error) Is(target error) bool {
+	return target == ErrInvalidObject
+}
+
+// Unwrap returns the FieldErrors, making them and the errors configured with the err rule checkable with
+// errors.Is and errors.As.
+func (e *Error) Unwrap() []error {
+	ret := make([]error, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		ret = append(ret, f)
+	}
+
+	return ret
+}
+
+// Validator collects the FieldErrors of an object.
+type Validator struct {
+	err Error
+}
+
+// New creates a Validator for an object of the given type name validated for the given operation.
+func New(object string, op types.Operation) *Validator {
+	return &Validator{
+		err: Error{
+			Object:    object,
+			Operation: op,
+		},
+	}
+}
+
+// Field returns a FieldValidator checking rules for the given field and value. The given errors are wrapped by
+// the FieldErrors for the field.
+func (v *Validator) Field(name string, value interface{}, errs ...error) *FieldValidator {
+	return &FieldValidator{
+		validator: v,
+		name:      name,
+		value:     reflect.ValueOf(value),
+		err:       errors.Join(errs...),
+	}
+}
+
+// Err returns an *Error if any field is invalid, nil otherwise.
+func (v *Validator) Err() error {
+	if len(v.err.Fields) == 0 {
+		return nil
+	}
+
+	ret := v.err
+	return &ret
+}
+
+// FieldValidator checks rules for a single field, every method returns the FieldValidator to allow chaining.
+type FieldValidator struct {
+	validator *Validator
+	name      string
+	value     reflect.Value
+	err       error
+}
+
+func (f *FieldValidator) fail(format string, args ...interface{}) {
+	f.validator.err.Fields = append(f.validator.err.Fields, FieldError{
+		Field:   f.name,
+		Message: fmt.Sprintf(format, args...),
+		Err:     f.err,
+	})
+}
+
+// Required checks the field is not empty.
+func (f *FieldValidator) Required() *FieldValidator {
+	if isEmpty(f.value) {
+		f.fail("is required")
+	}
+
+	return f
+}
+
+// Enum checks the value is one of the allowed values.
+func (f *FieldValidator) Enum(allowed ...string) *FieldValidator {
+	for _, s := range stringValues(f.value) {
+		if !contains(allowed, s) {
+			f.fail("must be one of %v, got %q", strings.Join(allowed, ", "), s)
+			break
+		}
+	}
+
+	return f
+}
+
+// Min checks the value (numbers) or length (strings, slices and maps) is at least min.
+func (f *FieldValidator) Min(min float64) *FieldValidator {
+	if n, isLength, ok := number(f.value); ok && n < min {
+		if isLength {
+			f.fail("must have a length of at least %v", min)
+		} else {
+			f.fail("must be at least %v", min)
+		}
+	}
+
+	return f
+}
+
+// Max checks the value (numbers) or length (strings, slices and maps) is at most max.
+func (f *FieldValidator) Max(max float64) *FieldValidator {
+	if n, isLength, ok := number(f.value); ok && n > max {
+		if isLength {
+			f.fail("must have a length of at most %v", max)
+		} else {
+			f.fail("must be at most %v", max)
+		}
+	}
+
+	return f
+}
+
+// CIDR checks the value is an IP network in CIDR notation.
+func (f *FieldValidator) CIDR() *FieldValidator {
+	for _, s := range stringValues(f.value) {
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			f.fail("must be an IP network in CIDR notation, got %q", s)
+			break
+		}
+	}
+
+	return f
+}
+
+var hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// Hostname checks the value is a RFC 1123 hostname.
+func (f *FieldValidator) Hostname() *FieldValidator {
+	for _, s := range stringValues(f.value) {
+		if !isHostname(s) {
+			f.fail("must be a RFC 1123 hostname, got %q", s)
+			break
+		}
+	}
+
+	return f
+}
+
+func isHostname(s string) bool {
+	if len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !hostnameLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Lowercase checks the value does not contain uppercase characters.
+func (f *FieldValidator) Lowercase() *FieldValidator {
+	for _, s := range stringValues(f.value) {
+		if s != strings.ToLower(s) {
+			f.fail("must be lowercase, got %q", s)
+			break
+		}
+	}
+
+	return f
+}
+
+var patternCache sync.Map
+
+// Pattern checks the value matches the given regular expression.
+func (f *FieldValidator) Pattern(pattern string) *FieldValidator {
+	re, ok := patternCache.Load(pattern)
+	if !ok {
+		re, _ = patternCache.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+
+	for _, s := range stringValues(f.value) {
+		if !re.(*regexp.Regexp).MatchString(s) {
+			f.fail("must match %q, got %q", pattern, s)
+			break
+		}
+	}
+
+	return f
+}
+
+// indirect follows pointers and interfaces, returning false for nil values.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+
+	return v, v.IsValid()
+}
+
+func isEmpty(v reflect.Value) bool {
+	v, ok := indirect(v)
+	if !ok {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Struct:
+		if identifier, ok := types.IdentifierField(v); ok {
+			return isEmpty(identifier)
+		}
+		return v.IsZero()
+	default:
+		return v.IsZero()
+	}
+}
+
+// stringValues returns the non-empty values of strings or slices of strings, other values are formatted with fmt.
+func stringValues(v reflect.Value) []string {
+	v, ok := indirect(v)
+	if !ok {
+		return nil
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		ret := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			ret = append(ret, stringValues(v.Index(i))...)
+		}
+		return ret
+	}
+
+	if isEmpty(v) {
+		return nil
+	}
+
+	if v.Kind() == reflect.String {
+		return []string{v.String()}
+	}
+
+	return []string{fmt.Sprint(v.Interface())}
+}
+
+// number returns the value of numbers or the length of strings, slices and maps.
+func number(v reflect.Value) (n float64, isLength, ok bool) {
+	v, ok = indirect(v)
+	if !ok {
+		return 0, false, false
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), false, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), false, true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), false, true
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return float64(v.Len()), true, true
+	default:
+		return 0, false, false
+	}
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package validation_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/api/validation"
+)
+
+var errTest = errors.New("test error")
+
+// reference is a reference to another Object, like lbaasv1.Backend.LoadBalancer.
+type reference struct {
+	Identifier string `anxcloud:"identifier"`
+	Name       string
+}
+
+var _ = Describe("Validator", func() {
+	var v *validation.Validator
+
+	BeforeEach(func() {
+		v = validation.New("Test", types.OperationCreate)
+	})
+
+	It("returns nil without invalid fields", func() {
+		v.Field("Name", "foo").Required().Hostname().Lowercase()
+		Expect(v.Err()).To(Succeed())
+	})
+
+	It("returns an *Error with all invalid fields", func() {
+		v.Field("Name", "").Required()
+		v.Field("Mode", "udp", errTest).Enum("tcp", "http")
+		v.Field("Count", 0).Required()
+
+		err := v.Err()
+		Expect(err).To(MatchError(validation.ErrInvalidObject))
+		Expect(err).To(MatchError(errTest))
+		Expect(err).To(MatchError(`invalid object Test for Create: Name: is required; Mode: must be one of tcp, http, got "udp" (test error); Count: is required`))
+
+		var validationError *validation.Error
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.Object).To(Equal("Test"))
+		Expect(validationError.Operation).To(Equal(types.OperationCreate))
+		Expect(validationError.Fields).To(HaveLen(3))
+
+		var fieldError validation.FieldError
+		Expect(errors.As(err, &fieldError)).To(BeTrue())
+		Expect(fieldError.Field).To(Equal("Name"))
+	})
+
+	DescribeTable("checks rules",
+		func(check func(v *validation.Validator), valid bool) {
+			check(v)
+
+			if valid {
+				Expect(v.Err()).To(Succeed())
+			} else {
+				Expect(v.Err()).To(MatchError(validation.ErrInvalidObject))
+			}
+		},
+		Entry("required with string", func(v *validation.Validator) { v.Field("F", "foo").Required() }, true),
+		Entry("required with empty slice", func(v *validation.Validator) { v.Field("F", []string{}).Required() }, false),
+		Entry("required with nil pointer", func(v *validation.Validator) { v.Field("F", (*string)(nil)).Required() }, false),
+		Entry("required with zero struct", func(v *validation.Validator) { v.Field("F", struct{ A string }{}).Required() }, false),
+		Entry("required with struct", func(v *validation.Validator) { v.Field("F", struct{ A string }{"a"}).Required() }, true),
+		Entry("required with reference", func(v *validation.Validator) { v.Field("F", reference{Identifier: "id"}).Required() }, true),
+		Entry("required with reference without identifier", func(v *validation.Validator) { v.Field("F", reference{Name: "name"}).Required() }, false),
+
+		Entry("enum with allowed value", func(v *validation.Validator) { v.Field("F", "a").Enum("a", "b") }, true),
+		Entry("enum with empty value", func(v *validation.Validator) { v.Field("F", "").Enum("a", "b") }, true),
+		Entry("enum with other value", func(v *validation.Validator) { v.Field("F", "c").Enum("a", "b") }, false),
+		Entry("enum with slice", func(v *validation.Validator) { v.Field("F", []string{"a", "c"}).Enum("a", "b") }, false),
+
+		Entry("min with number in range", func(v *validation.Validator) { v.Field("F", 3).Min(3) }, true),
+		Entry("min with number out of range", func(v *validation.Validator) { v.Field("F", 2).Min(3) }, false),
+		Entry("min with empty slice", func(v *validation.Validator) { v.Field("F", []int(nil)).Min(1) }, false),
+		Entry("max with number in range", func(v *validation.Validator) { v.Field("F", 1.5).Max(2) }, true),
+		Entry("max with long string", func(v *validation.Validator) { v.Field("F", "foo").Max(2) }, false),
+		Entry("max with nil pointer", func(v *validation.Validator) { v.Field("F", (*int)(nil)).Max(2) }, true),
+
+		Entry("cidr with IPv4 network", func(v *validation.Validator) { v.Field("F", "10.0.0.0/8").CIDR() }, true),
+		Entry("cidr with IPv6 network", func(v *validation.Validator) { v.Field("F", "2001:db8::/32").CIDR() }, true),
+		Entry("cidr with address", func(v *validation.Validator) { v.Field("F", "10.0.0.1").CIDR() }, false),
+
+		Entry("hostname with valid name", func(v *validation.Validator) { v.Field("F", "foo-1.example.com").Hostname() }, true),
+		Entry("hostname with underscore", func(v *validation.Validator) { v.Field("F", "foo_1").Hostname() }, false),
+		Entry("hostname with leading dash", func(v *validation.Validator) { v.Field("F", "-foo").Hostname() }, false),
+
+		Entry("lowercase with lowercase value", func(v *validation.Validator) { v.Field("F", "foo").Lowercase() }, true),
+		Entry("lowercase with uppercase value", func(v *validation.Validator) { v.Field("F", "Foo").Lowercase() }, false),
+
+		Entry("pattern with matching value", func(v *validation.Validator) { v.Field("F", "ab").Pattern(`^a[b,c]$`) }, true),
+		Entry("pattern with other value", func(v *validation.Validator) { v.Field("F", "ad").Pattern(`^a[b,c]$`) }, false),
+	)
+})
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/api/validation"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("validating objects", func() {
+	var server *ghttp.Server
+	var api API
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		a, err := NewAPI(WithClientOptions(
+			client.IgnoreMissingToken(),
+			client.BaseURL(server.URL()),
+		))
+		Expect(err).NotTo(HaveOccurred())
+		api = a
+	})
+
+	It("does not send invalid objects on Create", func() {
+		backend := lbaasv1.Backend{Mode: "udp"}
+
+		err := api.Create(context.TODO(), &backend)
+		Expect(err).To(MatchError(validation.ErrInvalidObject))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+
+		var validationError *validation.Error
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.Object).To(Equal("Backend"))
+		Expect(validationError.Operation).To(Equal(types.OperationCreate))
+		Expect(validationError.Fields).To(HaveLen(3))
+		Expect(validationError.Fields[0].Field).To(Equal("Name"))
+		Expect(validationError.Fields[1].Field).To(Equal("LoadBalancer"))
+		Expect(validationError.Fields[2].Field).To(Equal("Mode"))
+	})
+
+	It("does not send invalid objects on Update", func() {
+		backend := lbaasv1.Backend{Identifier: "foo", Mode: "udp"}
+
+		err := api.Update(context.TODO(), &backend)
+		Expect(err).To(MatchError(validation.ErrInvalidObject))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+
+		var validationError *validation.Error
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.Operation).To(Equal(types.OperationUpdate))
+		Expect(validationError.Fields).To(HaveLen(1))
+		Expect(validationError.Fields[0].Field).To(Equal("Mode"))
+	})
+
+	It("sends valid objects", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", "/api/LBaaS/v1/backend.json"),
+			ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo"}),
+		))
+
+		backend := lbaasv1.Backend{
+			Name:         "backend-01",
+			Mode:         lbaasv1.HTTP,
+			LoadBalancer: lbaasv1.LoadBalancer{Identifier: "bar"},
+		}
+
+		Expect(api.Create(context.TODO(), &backend)).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,457 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/utils/object/compare"
+)
+
+// ErrAlreadyRunning is returned by Run when the Informer is already running.
+var ErrAlreadyRunning = errors.New("informer is already running")
+
+// Informer periodically lists the Objects matching a filter Object, keeps them in a local store and emits Events
+// for their changes. Create it with NewInformer or retrieve a shared one from a Factory.
+type Informer struct {
+	api    types.API
+	filter types.FilterObject
+
+	interval       time.Duration
+	resyncInterval time.Duration
+	maxBackoff     time.Duration
+	pageSize       uint
+	listOptions    []types.ListOption
+	attributes     []string
+	errorHandler   func(error)
+	logger         *logr.Logger
+
+	// dispatchMu makes handlers being called one at a time, in the order the events are emitted. It is always
+	// locked before mu.
+	dispatchMu sync.Mutex
+	handlers   []*Handler
+
+	// mu protects the store and the state of the Informer
+	mu         sync.Mutex
+	store      map[string]types.Object
+	synced     bool
+	running    bool
+	lastResync time.Time
+}
+
+// NewInformer creates an Informer for the Objects matching the given filter Object, which are retrieved with List
+// operations on the given API. Run has to be called to start listing the Objects.
+func NewInformer(a types.API, filter types.FilterObject, opts ...Option) *Informer {
+	i := &Informer{
+		api:        a,
+		filter:     filter,
+		interval:   DefaultInterval,
+		maxBackoff: DefaultMaxBackoff,
+		pageSize:   DefaultPageSize,
+		store:      make(map[string]types.Object),
+	}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	if i.attributes == nil {
+		i.attributes = fieldNames(reflect.TypeOf(filter))
+	}
+
+	return i
+}
+
+// AddHandler adds a handler called with all future Events. It is called with Added events for the Objects already
+// in the store right away, making it see every Object.
+func (i *Informer) AddHandler(handler Handler) {
+	i.addHandler(&handler)
+}
+
+func (i *Informer) addHandler(handler *Handler) {
+	i.dispatchMu.Lock()
+	defer i.dispatchMu.Unlock()
+
+	i.handlers = append(i.handlers, handler)
+
+	i.mu.Lock()
+	existing := i.list()
+	i.mu.Unlock()
+
+	for _, o := range existing {
+		(*handler)(Event{Type: Added, Object: o})
+	}
+}
+
+func (i *Informer) removeHandler(handler *Handler) {
+	i.dispatchMu.Lock()
+	defer i.dispatchMu.Unlock()
+
+	handlers := make([]*Handler, 0, len(i.handlers))
+	for _, h := range i.handlers {
+		if h != handler {
+			handlers = append(handlers, h)
+		}
+	}
+
+	i.handlers = handlers
+}
+
+// Events returns a channel receiving all future Events, starting with Added events for the Objects already in the
+// store. Sending to the channel delays the next listing until the Event is received, the channel is closed and no
+// longer receives events when the given context is cancelled.
+func (i *Informer) Events(ctx context.Context) <-chan Event {
+	c := make(chan Event)
+
+	handler := Handler(func(e Event) {
+		select {
+		case <-ctx.Done():
+		case c <- e:
+		}
+	})
+
+	go func() {
+		i.addHandler(&handler)
+
+		<-ctx.Done()
+
+		// removing the handler waits for it to return, making it safe to close the channel afterwards
+		i.removeHandler(&handler)
+		close(c)
+	}()
+
+	return c
+}
+
+// HasSynced returns if the Objects were listed successfully at least once.
+func (i *Informer) HasSynced() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.synced
+}
+
+// List returns the Objects in the store, sorted by identifier. They are shared with the Informer and must not be
+// modified.
+func (i *Informer) List() []types.Object {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.list()
+}
+
+// Get returns the Object with the given identifier from the store, shared with the Informer and not to be
+// modified.
+func (i *Informer) Get(identifier string) (types.Object, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	o, ok := i.store[identifier]
+	return o, ok
+}
+
+// Run lists the Objects in the configured interval until the given context is cancelled, returning the error of
+// the context. ErrAlreadyRunning is returned when the Informer is already running.
+func (i *Informer) Run(ctx context.Context) error {
+	i.mu.Lock()
+	if i.running {
+		i.mu.Unlock()
+		return ErrAlreadyRunning
+	}
+	i.running = true
+	i.mu.Unlock()
+
+	defer func() {
+		i.mu.Lock()
+		i.running = false
+		i.mu.Unlock()
+	}()
+
+	failures := 0
+
+	for {
+		delay := i.interval
+
+		if err := i.poll(ctx); err != nil && ctx.Err() == nil {
+			failures++
+			delay = i.backoff(err, failures)
+			i.handleError(ctx, err)
+		} else {
+			failures = 0
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the time to wait after the given number of failed listings, doubling the interval for every
+// failure and waiting as long as requested by the Engine when rate limited.
+func (i *Informer) backoff(err error, failures int) time.Duration {
+	delay := i.interval
+	for n := 0; n < failures && delay < i.maxBackoff; n++ {
+		delay *= 2
+	}
+
+	if delay > i.maxBackoff {
+		delay = i.maxBackoff
+	}
+
+	var rateLimitError api.RateLimitError
+	if errors.As(err, &rateLimitError) {
+		if retryAfter := time.Until(rateLimitError.RetryAfter); retryAfter > delay {
+			delay = retryAfter
+		}
+	}
+
+	return delay
+}
+
+func (i *Informer) handleError(ctx context.Context, err error) {
+	if i.errorHandler != nil {
+		i.errorHandler(err)
+		return
+	}
+
+	logger := logr.FromContextOrDiscard(ctx)
+	if i.logger != nil {
+		logger = *i.logger
+	}
+
+	logger.Error(err, "Error listing objects", "resource", reflect.TypeOf(i.filter).Elem().String())
+}
+
+// poll lists the Objects, updates the store and emits the Events for all changes.
+func (i *Informer) poll(ctx context.Context) error {
+	listed, order, err := i.listObjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.dispatchMu.Lock()
+	defer i.dispatchMu.Unlock()
+
+	i.mu.Lock()
+
+	resync := i.resyncInterval > 0 && time.Since(i.lastResync) >= i.resyncInterval
+	if resync || i.lastResync.IsZero() {
+		i.lastResync = time.Now()
+	}
+
+	events := make([]Event, 0)
+
+	for _, identifier := range order {
+		o := listed[identifier]
+		old, ok := i.store[identifier]
+
+		if !ok {
+			events = append(events, Event{Type: Added, Object: o})
+			continue
+		}
+
+		differences, err := compare.Compare(old, o, i.attributes...)
+		if err != nil {
+			i.mu.Unlock()
+			return fmt.Errorf("compare objects: %w", err)
+		}
+
+		if len(differences) > 0 || resync {
+			events = append(events, Event{Type: Modified, Object: o, OldObject: old, Differences: differences})
+		}
+	}
+
+	for _, o := range i.list() {
+		identifier, _ := o.GetIdentifier(ctx)
+		if _, ok := listed[identifier]; !ok {
+			events = append(events, Event{Type: Deleted, Object: o})
+		}
+	}
+
+	i.store = listed
+	i.synced = true
+	i.mu.Unlock()
+
+	for _, e := range events {
+		for _, handler := range i.handlers {
+			(*handler)(e)
+		}
+	}
+
+	return nil
+}
+
+// listObjects lists all pages of Objects, returning them by identifier and the identifiers in the listed order.
+func (i *Informer) listObjects(ctx context.Context) (map[string]types.Object, []string, error) {
+	var pi types.PageInfo
+	if err := i.api.List(ctx, i.filter, append(i.listOptions, api.Paged(1, i.pageSize, &pi))...); err != nil {
+		return nil, nil, err
+	}
+
+	objects := make(map[string]types.Object)
+	order := make([]string, 0)
+
+	page := reflect.New(reflect.SliceOf(reflect.TypeOf(i.filter).Elem()))
+	for pi.Next(page.Interface()) && page.Elem().Len() > 0 {
+		for n := 0; n < page.Elem().Len(); n++ {
+			o := page.Elem().Index(n).Addr().Interface().(types.Object)
+
+			identifier, err := o.GetIdentifier(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, ok := objects[identifier]; !ok {
+				order = append(order, identifier)
+			}
+			objects[identifier] = o
+		}
+
+		page = reflect.New(page.Elem().Type())
+	}
+
+	if err := pi.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	return objects, order, nil
+}
+
+// list returns the Objects in the store sorted by identifier, mu has to be held.
+func (i *Informer) list() []types.Object {
+	identifiers := make([]string, 0, len(i.store))
+	for identifier := range i.store {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	objects := make([]types.Object, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		objects = append(objects, i.store[identifier])
+	}
+
+	return objects
+}
+
+// fieldNames returns the names of all exported fields of the struct type, compared to detect modified Objects.
+func fieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	names := make([]string, 0, t.NumField())
+	for n := 0; n < t.NumField(); n++ {
+		if t.Field(n).IsExported() {
+			names = append(names, t.Field(n).Name)
+		}
+	}
+
+	return names
+}
+
+// Factory hands out Informers shared between all consumers interested in the same Objects.
+type Factory struct {
+	api  types.API
+	opts []Option
+
+	mu        sync.Mutex
+	informers map[string]*Informer
+	ctx       context.Context
+}
+
+// NewFactory creates a Factory for Informers listing Objects with the given API, configured with the given
+// options.
+func NewFactory(a types.API, opts ...Option) *Factory {
+	return &Factory{
+		api:       a,
+		opts:      opts,
+		informers: make(map[string]*Informer),
+	}
+}
+
+// Informer returns the Informer for the Objects matching the given filter Object, shared with all other callers
+// passing a filter Object of the same type with the same attributes. The options given are only applied when the
+// Informer is created, after the options of the Factory. Informers created after Start are started right away.
+func (f *Factory) Informer(filter types.FilterObject, opts ...Option) *Informer {
+	key := informerKey(filter)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if informer, ok := f.informers[key]; ok {
+		return informer
+	}
+
+	informer := NewInformer(f.api, filter, append(append([]Option{}, f.opts...), opts...)...)
+	f.informers[key] = informer
+
+	if f.ctx != nil {
+		go func() { _ = informer.Run(f.ctx) }()
+	}
+
+	return informer
+}
+
+// Start runs all Informers of the Factory until the given context is cancelled, including those created later.
+func (f *Factory) Start(ctx context.Context) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.ctx != nil {
+		return
+	}
+
+	f.ctx = ctx
+	for _, informer := range f.informers {
+		go func(informer *Informer) { _ = informer.Run(ctx) }(informer)
+	}
+}
+
+// WaitForSync waits until all Informers of the Factory listed their Objects at least once, returning false if the
+// given context was cancelled before.
+func (f *Factory) WaitForSync(ctx context.Context) bool {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		f.mu.Lock()
+		synced := true
+		for _, informer := range f.informers {
+			synced = synced && informer.HasSynced()
+		}
+		f.mu.Unlock()
+
+		if synced {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+func informerKey(filter types.FilterObject) string {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%p", filter))
+	}
+
+	return reflect.TypeOf(filter).String() + string(data)
+}
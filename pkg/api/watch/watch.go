@@ -0,0 +1,139 @@
+// Package watch notices changes of Objects by periodically listing them with the generic API client, as the
+// Engine has no endpoint to watch for changes.
+//
+// An Informer lists the Objects matching a filter Object in the configured interval, keeps them in a local store
+// and emits an Event for every Object added, modified or deleted since the previous listing. Events are passed to
+// handlers added with AddHandler or sent to channels returned by Events:
+//
+//	informer := watch.NewInformer(a, &vlanv1.VLAN{}, watch.WithInterval(time.Minute))
+//	informer.AddHandler(func(e watch.Event) {
+//		if vlan := e.Object.(*vlanv1.VLAN); e.Type != watch.Deleted && vlan.Status == vlanv1.StatusActive {
+//			fmt.Printf("VLAN %v is active\n", vlan.Identifier)
+//		}
+//	})
+//
+//	go informer.Run(ctx)
+//
+// Informers retrieved from a Factory are shared between all consumers interested in the same Objects, making
+// them not multiply the load on the Engine.
+package watch
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/utils/object/compare"
+)
+
+const (
+	// DefaultInterval is the time between two listings, unless configured otherwise with WithInterval.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultMaxBackoff is the maximum time between two listings after errors, unless configured otherwise
+	// with WithMaxBackoff.
+	DefaultMaxBackoff = 10 * time.Minute
+
+	// DefaultPageSize is the number of Objects retrieved per page, unless configured otherwise with WithPageSize.
+	DefaultPageSize = 100
+)
+
+// EventType is the kind of change of an Object.
+type EventType string
+
+const (
+	// Added is emitted for Objects not listed before, including every Object of the first listing.
+	Added EventType = "Added"
+
+	// Modified is emitted for Objects with attributes different from the previous listing. It is emitted for
+	// unchanged Objects, too, on every resync, configured with WithResyncInterval.
+	Modified EventType = "Modified"
+
+	// Deleted is emitted for Objects listed before, but not anymore.
+	Deleted EventType = "Deleted"
+)
+
+// Event describes the change of a single Object.
+type Event struct {
+	Type EventType
+
+	// Object is the Object as listed, the last listed state of it for Deleted events. It is shared with other
+	// handlers and the store of the Informer and must not be modified.
+	Object types.Object
+
+	// OldObject is the previous state of the Object for Modified events, nil otherwise.
+	OldObject types.Object
+
+	// Differences between OldObject and Object for Modified events, empty for resyncs.
+	Differences []compare.Difference
+}
+
+// Handler is called with every Event, in the order they are emitted. Handlers are called one at a time and delay
+// the next listing, long running work should be done in a separate goroutine.
+type Handler func(Event)
+
+// Option configures an Informer.
+type Option func(*Informer)
+
+// WithInterval sets the time between two listings, defaulting to DefaultInterval.
+func WithInterval(interval time.Duration) Option {
+	return func(i *Informer) {
+		i.interval = interval
+	}
+}
+
+// WithResyncInterval makes the Informer emit Modified events for all unchanged Objects in the given interval,
+// allowing handlers to periodically reconcile every Object. Disabled by default.
+func WithResyncInterval(interval time.Duration) Option {
+	return func(i *Informer) {
+		i.resyncInterval = interval
+	}
+}
+
+// WithMaxBackoff sets the maximum time between two listings after errors, defaulting to DefaultMaxBackoff. The
+// time between listings is doubled for every failed listing and extended to the time given by the Engine when
+// rate limited.
+func WithMaxBackoff(maxBackoff time.Duration) Option {
+	return func(i *Informer) {
+		i.maxBackoff = maxBackoff
+	}
+}
+
+// WithPageSize sets the number of Objects retrieved per page, defaulting to DefaultPageSize.
+func WithPageSize(pageSize uint) Option {
+	return func(i *Informer) {
+		i.pageSize = pageSize
+	}
+}
+
+// WithListOptions sets additional options for the List operations, like api.FullObjects.
+func WithListOptions(opts ...types.ListOption) Option {
+	return func(i *Informer) {
+		i.listOptions = append(i.listOptions, opts...)
+	}
+}
+
+// WithAttributes sets the (dot-nested) attribute names compared to detect modified Objects, passed to
+// compare.Compare. Defaults to all fields of the Object.
+func WithAttributes(attributes ...string) Option {
+	return func(i *Informer) {
+		i.attributes = attributes
+	}
+}
+
+// WithErrorHandler sets a function called with errors of listings, which are logged with the logger of the
+// context given to Run by default.
+func WithErrorHandler(handler func(error)) Option {
+	return func(i *Informer) {
+		i.errorHandler = handler
+	}
+}
+
+// WithLogger sets the logger used to log errors of listings when no error handler is configured, replacing the
+// logger of the context given to Run.
+func WithLogger(logger logr.Logger) Option {
+	return func(i *Informer) {
+		i.logger = &logger
+	}
+}
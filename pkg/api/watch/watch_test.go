@@ -0,0 +1,220 @@
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+
+	"go.anx.io/go-anxcloud/pkg/api/mock"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/api/watch"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+	"go.anx.io/go-anxcloud/pkg/utils/object/compare"
+)
+
+var errList = errors.New("list failed")
+
+// countingAPI counts List operations and fails them while err is set.
+type countingAPI struct {
+	types.API
+	lists atomic.Int64
+	err   atomic.Pointer[error]
+}
+
+func (a *countingAPI) List(ctx context.Context, o types.FilterObject, opts ...types.ListOption) error {
+	a.lists.Add(1)
+	if err := a.err.Load(); err != nil {
+		return *err
+	}
+
+	return a.API.List(ctx, o, opts...)
+}
+
+func eventOf(eventType watch.EventType, identifier string) gomegatypes.GomegaMatcher {
+	return And(
+		HaveField("Type", eventType),
+		HaveField("Object.Identifier", identifier),
+	)
+}
+
+func receive(events <-chan watch.Event, n int) []watch.Event {
+	received := make([]watch.Event, n)
+	for i := range received {
+		Eventually(events).Should(Receive(&received[i]))
+	}
+
+	return received
+}
+
+var _ = Describe("Informer", func() {
+	var a mock.API
+	var counting *countingAPI
+	var vlans []string
+
+	BeforeEach(func() {
+		a = mock.NewMockAPI()
+		counting = &countingAPI{API: a}
+
+		vlans = []string{
+			a.FakeExisting(&vlanv1.VLAN{Status: vlanv1.StatusPending, DescriptionCustomer: "vlan-01"}),
+			a.FakeExisting(&vlanv1.VLAN{Status: vlanv1.StatusActive, DescriptionCustomer: "vlan-02"}),
+		}
+	})
+
+	run := func(informer *watch.Informer) <-chan watch.Event {
+		ctx, cancel := context.WithCancel(context.TODO())
+		DeferCleanup(cancel)
+
+		events := informer.Events(ctx)
+		go func() { _ = informer.Run(ctx) }()
+
+		return events
+	}
+
+	It("emits events for added, modified and deleted objects", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{}, watch.WithInterval(10*time.Millisecond))
+		events := run(informer)
+
+		Expect(receive(events, 2)).To(ConsistOf(eventOf(watch.Added, vlans[0]), eventOf(watch.Added, vlans[1])))
+		Expect(informer.HasSynced()).To(BeTrue())
+		Expect(informer.List()).To(HaveLen(2))
+
+		Expect(a.Update(context.TODO(), &vlanv1.VLAN{Identifier: vlans[0], Status: vlanv1.StatusActive})).To(Succeed())
+
+		var e watch.Event
+		Eventually(events).Should(Receive(&e))
+		Expect(e).To(eventOf(watch.Modified, vlans[0]))
+		Expect(e.OldObject).To(HaveField("Status", vlanv1.StatusPending))
+		Expect(e.Differences).To(ConsistOf(compare.Difference{Key: "Status", A: vlanv1.StatusPending, B: vlanv1.StatusActive}))
+
+		Expect(a.Destroy(context.TODO(), &vlanv1.VLAN{Identifier: vlans[1]})).To(Succeed())
+		Eventually(events).Should(Receive(eventOf(watch.Deleted, vlans[1])))
+
+		o, ok := informer.Get(vlans[0])
+		Expect(ok).To(BeTrue())
+		Expect(o).To(HaveField("Status", vlanv1.StatusActive))
+	})
+
+	It("only compares the configured attributes", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{},
+			watch.WithInterval(10*time.Millisecond),
+			watch.WithAttributes(vlanv1.VLANFields.Status),
+		)
+		events := run(informer)
+		receive(events, 2)
+
+		Expect(a.Update(context.TODO(), &vlanv1.VLAN{Identifier: vlans[0], DescriptionCustomer: "changed"})).To(Succeed())
+		Consistently(events, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("emits Modified events for unchanged objects on resync", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{},
+			watch.WithInterval(10*time.Millisecond),
+			watch.WithResyncInterval(10*time.Millisecond),
+		)
+		events := run(informer)
+
+		Expect(receive(events, 2)).To(HaveEach(HaveField("Type", watch.Added)))
+
+		e := receive(events, 1)[0]
+		Expect(e.Type).To(Equal(watch.Modified))
+		Expect(e.Differences).To(BeEmpty())
+	})
+
+	It("calls handlers with the objects already in the store", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{}, watch.WithInterval(10*time.Millisecond))
+		receive(run(informer), 2)
+
+		var added atomic.Int64
+		informer.AddHandler(func(e watch.Event) {
+			if e.Type == watch.Added {
+				added.Add(1)
+			}
+		})
+
+		Expect(added.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("reports errors and retries with backoff", func() {
+		counting.err.Store(&errList)
+
+		var reported atomic.Int64
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{},
+			watch.WithInterval(time.Millisecond),
+			watch.WithMaxBackoff(20*time.Millisecond),
+			watch.WithErrorHandler(func(err error) {
+				Expect(err).To(MatchError(errList))
+				reported.Add(1)
+			}),
+		)
+		events := run(informer)
+
+		Eventually(reported.Load).Should(BeNumerically(">=", 3))
+		Expect(informer.HasSynced()).To(BeFalse())
+
+		counting.err.Store(nil)
+		Expect(receive(events, 2)).To(HaveEach(HaveField("Type", watch.Added)))
+		Expect(informer.HasSynced()).To(BeTrue())
+	})
+
+	It("does not run twice", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{}, watch.WithInterval(10*time.Millisecond))
+		run(informer)
+
+		Eventually(informer.HasSynced).Should(BeTrue())
+		Expect(informer.Run(context.TODO())).To(MatchError(watch.ErrAlreadyRunning))
+	})
+
+	It("closes event channels when their context is cancelled", func() {
+		informer := watch.NewInformer(counting, &vlanv1.VLAN{}, watch.WithInterval(10*time.Millisecond))
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		DeferCleanup(cancel)
+		go func() { _ = informer.Run(ctx) }()
+		Eventually(informer.HasSynced).Should(BeTrue())
+
+		eventsCtx, cancelEvents := context.WithCancel(context.TODO())
+		events := informer.Events(eventsCtx)
+		cancelEvents()
+
+		Eventually(events).Should(BeClosed())
+	})
+
+	Context("retrieved from a Factory", func() {
+		var factory *watch.Factory
+
+		BeforeEach(func() {
+			factory = watch.NewFactory(counting, watch.WithInterval(time.Hour))
+		})
+
+		It("shares informers for the same objects", func() {
+			informer := factory.Informer(&vlanv1.VLAN{})
+			Expect(factory.Informer(&vlanv1.VLAN{})).To(BeIdenticalTo(informer))
+			Expect(factory.Informer(&vlanv1.VLAN{Status: vlanv1.StatusActive})).NotTo(BeIdenticalTo(informer))
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			DeferCleanup(cancel)
+
+			factory.Start(ctx)
+			Expect(factory.WaitForSync(ctx)).To(BeTrue())
+			Expect(counting.lists.Load()).To(BeEquivalentTo(2))
+
+			Expect(informer.List()).To(HaveLen(2))
+		})
+
+		It("starts informers created after Start", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			DeferCleanup(cancel)
+
+			factory.Start(ctx)
+			informer := factory.Informer(&vlanv1.VLAN{})
+
+			Eventually(informer.HasSynced).Should(BeTrue())
+		})
+	})
+})
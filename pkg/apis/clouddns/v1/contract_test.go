@@ -0,0 +1,54 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	clouddnsv1 "go.anx.io/go-anxcloud/pkg/apis/clouddns/v1"
+	testutils "go.anx.io/go-anxcloud/pkg/utils/test"
+)
+
+// recordContractOptions configures the contract tests of Records, the Engine responding with the zone containing
+// the Record to Create and Update requests.
+func recordContractOptions() []testutils.ContractOption {
+	return []testutils.ContractOption{
+		testutils.ContractPrepare(func(o types.Object, _ types.Operation) {
+			// the endpoints depend on the zone, which cannot be changed
+			o.(*clouddnsv1.Record).ZoneName = "contract.example.com"
+		}),
+		testutils.ContractHandler(func(engine http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodPost && req.Method != http.MethodPut {
+					engine.ServeHTTP(w, req)
+					return
+				}
+
+				recorder := httptest.NewRecorder()
+				engine.ServeHTTP(recorder, req)
+
+				if recorder.Code != http.StatusOK {
+					w.WriteHeader(recorder.Code)
+					_, _ = w.Write(recorder.Body.Bytes())
+					return
+				}
+
+				var record clouddnsv1.Record
+				if err := json.Unmarshal(recorder.Body.Bytes(), &record); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(clouddnsv1.Zone{
+					Name:            "contract.example.com",
+					CurrentRevision: "current",
+					Revisions: []clouddnsv1.Revision{
+						{Identifier: "current", Records: []clouddnsv1.Record{record}},
+					},
+				})
+			})
+		}),
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"path"
 
@@ -115,21 +114,6 @@ func (r *Record) HasPagination(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
-// FilterAPIRequest checks whether a name was set on create operations
-// and returns ErrEmptyRecordNameNotSupported if not.
-func (r *Record) FilterAPIRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
-	op, err := types.OperationFromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	if op == types.OperationCreate && r.Name == "" {
-		return nil, ErrEmptyRecordNameNotSupported
-	}
-
-	return req, nil
-}
-
 func (r *Record) findInZone(zone *Zone) (*Record, error) {
 	var rev *Revision
 	for _, r := range zone.Revisions {
@@ -1,6 +1,6 @@
 package v1
 
-// anxcloud:object:hooks=ResponseDecodeHook,PaginationSupportHook,RequestFilterHook
+// anxcloud:object:hooks=ResponseDecodeHook,PaginationSupportHook:contract=recordContractOptions
 
 type Record struct {
 	Identifier string `json:"identifier,omitempty" anxcloud:"identifier"`
@@ -8,7 +8,7 @@ type Record struct {
 	Immutable  bool   `json:"immutable,omitempty"`
 	// Name of the DNS record.
 	// Use "@" to select the domain root. Creation of records with an empty Name field is not supported.
-	Name    string  `json:"name"`
+	Name    string  `json:"name" validate:"create,required,err=ErrEmptyRecordNameNotSupported"`
 	RData   string  `json:"rdata"`
 	Region  string  `json:"region"`
 	TTL     int     `json:"ttl"`
@@ -4,6 +4,9 @@ package v1
 
 import (
 	"context"
+	"slices"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
 )
 
 // GetIdentifier returns the primary identifier of a Record object
@@ -15,3 +18,262 @@ func (o *Record) GetIdentifier(ctx context.Context) (string, error) {
 func (o *Zone) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Name, nil
 }
+
+func init() {
+	types.RegisterResourceType("9190d73d8f4f42b5ad29e1a057f184fc", func(identifier, name string) types.Object {
+		return &Zone{Name: name}
+	})
+}
+
+// DeepCopy returns a deep copy of the Record object. Values stored in interface fields are copied shallowly.
+func (o *Record) DeepCopy() *Record {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Comment != nil {
+		v1 := *out.Comment
+		out.Comment = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Record object is equal to the given one, comparing all fields deeply.
+func (o *Record) Equal(other *Record) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.ZoneName != other.ZoneName {
+		return false
+	}
+	if o.Immutable != other.Immutable {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.RData != other.RData {
+		return false
+	}
+	if o.Region != other.Region {
+		return false
+	}
+	if o.TTL != other.TTL {
+		return false
+	}
+	if (o.Comment == nil) != (other.Comment == nil) {
+		return false
+	}
+	if o.Comment != nil {
+		if (*o.Comment) != (*other.Comment) {
+			return false
+		}
+	}
+	if o.Type != other.Type {
+		return false
+	}
+
+	return true
+}
+
+// RecordFields contains the names of the fields of Record objects, usable with compare.Compare and compare.Reconcile.
+var RecordFields = struct {
+	Identifier string
+	ZoneName   string
+	Immutable  string
+	Name       string
+	RData      string
+	Region     string
+	TTL        string
+	Comment    string
+	Type       string
+}{
+	Identifier: "Identifier",
+	ZoneName:   "ZoneName",
+	Immutable:  "Immutable",
+	Name:       "Name",
+	RData:      "RData",
+	Region:     "Region",
+	TTL:        "TTL",
+	Comment:    "Comment",
+	Type:       "Type",
+}
+
+// DeepCopy returns a deep copy of the Zone object. Values stored in interface fields are copied shallowly.
+func (o *Zone) DeepCopy() *Zone {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.NotifyAllowedIPs = slices.Clone(out.NotifyAllowedIPs)
+	out.DNSServers = slices.Clone(out.DNSServers)
+	out.Revisions = slices.Clone(out.Revisions)
+	for i1 := range out.Revisions {
+		out.Revisions[i1].Records = slices.Clone(out.Revisions[i1].Records)
+		for i3 := range out.Revisions[i1].Records {
+			out.Revisions[i1].Records[i3] = *out.Revisions[i1].Records[i3].DeepCopy()
+		}
+	}
+
+	return &out
+}
+
+// Equal returns if the Zone object is equal to the given one, comparing all fields deeply.
+func (o *Zone) Equal(other *Zone) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Name != other.Name {
+		return false
+	}
+	if o.IsMaster != other.IsMaster {
+		return false
+	}
+	if o.DNSSecMode != other.DNSSecMode {
+		return false
+	}
+	if o.AdminEmail != other.AdminEmail {
+		return false
+	}
+	if o.Refresh != other.Refresh {
+		return false
+	}
+	if o.Retry != other.Retry {
+		return false
+	}
+	if o.Expire != other.Expire {
+		return false
+	}
+	if o.TTL != other.TTL {
+		return false
+	}
+	if o.MasterNS != other.MasterNS {
+		return false
+	}
+	if (o.NotifyAllowedIPs == nil) != (other.NotifyAllowedIPs == nil) || len(o.NotifyAllowedIPs) != len(other.NotifyAllowedIPs) {
+		return false
+	}
+	for i1 := range o.NotifyAllowedIPs {
+		if o.NotifyAllowedIPs[i1] != other.NotifyAllowedIPs[i1] {
+			return false
+		}
+	}
+	if (o.DNSServers == nil) != (other.DNSServers == nil) || len(o.DNSServers) != len(other.DNSServers) {
+		return false
+	}
+	for i1 := range o.DNSServers {
+		if o.DNSServers[i1].Server != other.DNSServers[i1].Server {
+			return false
+		}
+		if o.DNSServers[i1].Alias != other.DNSServers[i1].Alias {
+			return false
+		}
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if !o.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	if !o.UpdatedAt.Equal(other.UpdatedAt) {
+		return false
+	}
+	if !o.PublishedAt.Equal(other.PublishedAt) {
+		return false
+	}
+	if o.IsEditable != other.IsEditable {
+		return false
+	}
+	if o.ValidationLevel != other.ValidationLevel {
+		return false
+	}
+	if o.DeploymentLevel != other.DeploymentLevel {
+		return false
+	}
+	if (o.Revisions == nil) != (other.Revisions == nil) || len(o.Revisions) != len(other.Revisions) {
+		return false
+	}
+	for i1 := range o.Revisions {
+		if !o.Revisions[i1].CreatedAt.Equal(other.Revisions[i1].CreatedAt) {
+			return false
+		}
+		if o.Revisions[i1].Identifier != other.Revisions[i1].Identifier {
+			return false
+		}
+		if !o.Revisions[i1].ModifiedAt.Equal(other.Revisions[i1].ModifiedAt) {
+			return false
+		}
+		if (o.Revisions[i1].Records == nil) != (other.Revisions[i1].Records == nil) || len(o.Revisions[i1].Records) != len(other.Revisions[i1].Records) {
+			return false
+		}
+		for i3 := range o.Revisions[i1].Records {
+			if !o.Revisions[i1].Records[i3].Equal(&other.Revisions[i1].Records[i3]) {
+				return false
+			}
+		}
+		if o.Revisions[i1].Serial != other.Revisions[i1].Serial {
+			return false
+		}
+		if o.Revisions[i1].State != other.Revisions[i1].State {
+			return false
+		}
+	}
+	if o.CurrentRevision != other.CurrentRevision {
+		return false
+	}
+
+	return true
+}
+
+// ZoneFields contains the names of the fields of Zone objects, usable with compare.Compare and compare.Reconcile.
+var ZoneFields = struct {
+	Name             string
+	IsMaster         string
+	DNSSecMode       string
+	AdminEmail       string
+	Refresh          string
+	Retry            string
+	Expire           string
+	TTL              string
+	MasterNS         string
+	NotifyAllowedIPs string
+	DNSServers       string
+	Customer         string
+	CreatedAt        string
+	UpdatedAt        string
+	PublishedAt      string
+	IsEditable       string
+	ValidationLevel  string
+	DeploymentLevel  string
+	Revisions        string
+	CurrentRevision  string
+}{
+	Name:             "Name",
+	IsMaster:         "IsMaster",
+	DNSSecMode:       "DNSSecMode",
+	AdminEmail:       "AdminEmail",
+	Refresh:          "Refresh",
+	Retry:            "Retry",
+	Expire:           "Expire",
+	TTL:              "TTL",
+	MasterNS:         "MasterNS",
+	NotifyAllowedIPs: "NotifyAllowedIPs",
+	DNSServers:       "DNSServers",
+	Customer:         "Customer",
+	CreatedAt:        "CreatedAt",
+	UpdatedAt:        "UpdatedAt",
+	PublishedAt:      "PublishedAt",
+	IsEditable:       "IsEditable",
+	ValidationLevel:  "ValidationLevel",
+	DeploymentLevel:  "DeploymentLevel",
+	Revisions:        "Revisions",
+	CurrentRevision:  "CurrentRevision",
+}
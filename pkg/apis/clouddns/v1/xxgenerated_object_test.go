@@ -13,7 +13,7 @@ import (
 var _ = Describe("Object Record", func() {
 	o := apipkg.Record{}
 
-	ifaces := make([]interface{}, 0, 4)
+	ifaces := make([]interface{}, 0, 3)
 	{
 		var i types.Object
 		ifaces = append(ifaces, &i)
@@ -26,12 +26,11 @@ var _ = Describe("Object Record", func() {
 		var i types.PaginationSupportHook
 		ifaces = append(ifaces, &i)
 	}
-	{
-		var i types.RequestFilterHook
-		ifaces = append(ifaces, &i)
-	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.RecordFields)
+	testutils.ContractTests(&o, recordContractOptions()...)
 })
 
 var _ = Describe("Object Zone", func() {
@@ -60,4 +59,6 @@ var _ = Describe("Object Zone", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ZoneFields)
 })
@@ -19,7 +19,7 @@ type DNSServer struct {
 	Alias string `json:"alias"`
 }
 
-// anxcloud:object:hooks=RequestFilterHook,RequestBodyHook,ResponseFilterHook,PaginationSupportHook
+// anxcloud:object:hooks=RequestFilterHook,RequestBodyHook,ResponseFilterHook,PaginationSupportHook:resourcetype=9190d73d8f4f42b5ad29e1a057f184fc:resourcename:nocontract
 
 type Zone struct {
 	// Zone name
@@ -17,6 +17,11 @@ type Tagger interface {
 	ListTags(context.Context, types.API, types.IdentifiedObject) ([]string, error)
 }
 
+// TagSyncer is implemented by Taggers able to sync the tags of generic client objects.
+type TagSyncer interface {
+	SyncTags(context.Context, types.API, types.IdentifiedObject, ...string) error
+}
+
 // TaggerImplementation is the Tagger to use, set on startup of a program. This is a really ugly workaround
 // for a circular-dependency between pkg/api and pkg/apis/core/v1 for automatically tagging Objects after
 // they are created.
@@ -1,6 +1,6 @@
 package v1
 
-// anxcloud:object
+// anxcloud:object:nocontract
 
 // Location describes a Anexia site where resources can be deployed.
 type Location struct {
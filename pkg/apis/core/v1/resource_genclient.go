@@ -36,7 +36,7 @@ func (r Resource) EndpointURL(ctx context.Context) (*url.URL, error) {
 		query := u.Query()
 
 		if len(r.Tags) > 1 {
-			logr.FromContextOrDiscard(ctx).Info("Listing with multiple tags isn't supported. Only first one used")
+			logr.FromContextOrDiscard(ctx).Info("Listing with multiple tags isn't supported. Only first one used, use ListResourcesByTags instead")
 		}
 
 		if len(r.Tags) > 0 {
@@ -118,6 +118,12 @@ func (rwt ResourceWithTag) FilterAPIRequestBody(ctx context.Context) (interface{
 	return "", nil
 }
 
+// ProtectionExempt excludes tag associations from protection policies, removing a tag from a protected resource
+// does not destroy the resource.
+func (rwt ResourceWithTag) ProtectionExempt() bool {
+	return true
+}
+
 func (rwt ResourceWithTag) FilterAPIResponse(ctx context.Context, res *http.Response) (*http.Response, error) {
 	if res.StatusCode == http.StatusOK {
 		res.StatusCode = http.StatusNoContent
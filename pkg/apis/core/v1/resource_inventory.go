@@ -0,0 +1,307 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// TagMatch configures how multiple tags are combined when listing resources by tags.
+type TagMatch int
+
+const (
+	// MatchAllTags only returns resources carrying all the given tags.
+	MatchAllTags TagMatch = iota
+	// MatchAnyTag returns resources carrying at least one of the given tags.
+	MatchAnyTag
+)
+
+// ErrNoTags is returned when listing or cleaning up resources by tags without giving any tag.
+var ErrNoTags = errors.New("at least one tag is required")
+
+// OtherResourceTypes is the entry of a cleanup order matching all types not listed explicitly.
+const OtherResourceTypes = "*"
+
+// DefaultCleanupOrder is the order in which CleanupResources destroys resources, each entry being a group
+// of object types (package path below pkg/apis and type name) destroyed concurrently. Dependent resources
+// are destroyed before the resources they depend on.
+var DefaultCleanupOrder = [][]string{
+	{"lbaas/v1.Rule", "lbaas/v1.ACL", "kubernetes/v1.NodePool", "clouddns/v1.Record"},
+	{"lbaas/v1.Bind", "lbaas/v1.Server"},
+	{"lbaas/v1.Frontend"},
+	{"lbaas/v1.Backend"},
+	{"lbaas/v1.LoadBalancer", "kubernetes/v1.Cluster"},
+	{OtherResourceTypes},
+	{"vlan/v1.VLAN"},
+}
+
+// ListResourcesByTags lists all resources carrying the given tags, combined as configured by match.
+// As the Engine only supports filtering by a single tag, resources are listed per tag and combined.
+func ListResourcesByTags(ctx context.Context, a types.API, match TagMatch, tags ...string) ([]Resource, error) {
+	if len(tags) == 0 {
+		return nil, ErrNoTags
+	}
+
+	var (
+		order  []string
+		byID   = make(map[string]Resource)
+		counts = make(map[string]int)
+	)
+
+	for _, tag := range tags {
+		resources, err := listResourcesByTag(ctx, a, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range resources {
+			if _, ok := byID[r.Identifier]; !ok {
+				byID[r.Identifier] = r
+				order = append(order, r.Identifier)
+			}
+
+			counts[r.Identifier]++
+		}
+	}
+
+	resources := make([]Resource, 0, len(order))
+	for _, id := range order {
+		if match == MatchAnyTag || counts[id] == len(tags) {
+			resources = append(resources, byID[id])
+		}
+	}
+
+	return resources, nil
+}
+
+func listResourcesByTag(ctx context.Context, a types.API, tag string) ([]Resource, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &Resource{Tags: []string{tag}}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed to list resources with tag %q: %w", tag, err)
+	}
+
+	resources := make([]Resource, 0)
+	for retriever := range channel {
+		var r Resource
+		if err := retriever(&r); err != nil {
+			return nil, fmt.Errorf("failed to retrieve resource: %w", err)
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// GroupByType groups the given resources by their resource type.
+func GroupByType(resources []Resource) map[Type][]Resource {
+	groups := make(map[Type][]Resource)
+	for _, r := range resources {
+		groups[r.Type] = append(groups[r.Type], r)
+	}
+
+	return groups
+}
+
+// CleanupOptions configures CleanupResources.
+type CleanupOptions struct {
+	// Tags the resources to destroy have to carry, combined as configured by Match. Required.
+	Tags  []string
+	Match TagMatch
+
+	// DryRun only reports what would be destroyed.
+	DryRun bool
+
+	// Concurrency is the maximum number of resources destroyed at the same time, 4 if zero.
+	Concurrency int
+
+	// Order is the order resources are destroyed in, DefaultCleanupOrder if nil.
+	Order [][]string
+}
+
+// CleanupStatus is the outcome of cleaning up a single resource.
+type CleanupStatus string
+
+const (
+	// CleanupStatusDestroyed marks a destroyed resource.
+	CleanupStatusDestroyed CleanupStatus = "destroyed"
+	// CleanupStatusPlanned marks a resource which would be destroyed without dry-run.
+	CleanupStatusPlanned CleanupStatus = "planned"
+	// CleanupStatusSkipped marks a resource not destroyed as no Object is registered for its type.
+	CleanupStatusSkipped CleanupStatus = "skipped"
+	// CleanupStatusFailed marks a resource which could not be destroyed.
+	CleanupStatusFailed CleanupStatus = "failed"
+)
+
+// CleanupResult is the outcome of cleaning up a single resource.
+type CleanupResult struct {
+	Resource Resource
+	Status   CleanupStatus
+
+	// Err is the reason the resource was skipped or failed to be destroyed.
+	Err error
+}
+
+// CleanupReport summarizes a CleanupResources run, with results in the order resources were processed.
+type CleanupReport struct {
+	Results []CleanupResult
+}
+
+// Count returns the number of resources with the given status.
+func (r *CleanupReport) Count(status CleanupStatus) int {
+	count := 0
+	for _, res := range r.Results {
+		if res.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Err returns the errors of all failed resources joined, nil if none failed.
+func (r *CleanupReport) Err() error {
+	var errs []error
+	for _, res := range r.Results {
+		if res.Status == CleanupStatusFailed {
+			errs = append(errs, fmt.Errorf("resource %q (%s): %w", res.Resource.Identifier, res.Resource.Type.Name, res.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// String returns a short summary of the report like "3 destroyed, 0 planned, 1 skipped, 0 failed".
+func (r *CleanupReport) String() string {
+	return fmt.Sprintf("%d destroyed, %d planned, %d skipped, %d failed",
+		r.Count(CleanupStatusDestroyed), r.Count(CleanupStatusPlanned), r.Count(CleanupStatusSkipped), r.Count(CleanupStatusFailed))
+}
+
+// CleanupResources destroys all resources carrying the configured tags, in the configured dependency order.
+// Resources are destroyed via the generic API Object registered for their resource type (see
+// api.RegisterResourceType) or discovered for it (see ResolveResource), resources of types without registered
+// or discovered Object are skipped. Failing to destroy a resource does not stop the cleanup, all failures are
+// returned joined in addition to the report.
+func CleanupResources(ctx context.Context, a types.API, opts CleanupOptions) (*CleanupReport, error) {
+	resources, err := ListResourcesByTags(ctx, a, opts.Match, opts.Tags...)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Order == nil {
+		opts.Order = DefaultCleanupOrder
+	}
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+
+	report := &CleanupReport{}
+	phases := make([][]cleanupItem, len(opts.Order)+1)
+
+	for _, r := range resources {
+		obj, err := newResourceObject(ctx, a, r)
+		if errors.Is(err, api.ErrUnknownResourceType) {
+			report.Results = append(report.Results, CleanupResult{Resource: r, Status: CleanupStatusSkipped, Err: err})
+			continue
+		} else if err != nil {
+			report.Results = append(report.Results, CleanupResult{Resource: r, Status: CleanupStatusFailed, Err: err})
+			continue
+		}
+
+		phase := cleanupPhase(opts.Order, types.ObjectTypeName(obj))
+		phases[phase] = append(phases[phase], cleanupItem{resource: r, object: obj})
+	}
+
+	for _, items := range phases {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].resource.Identifier < items[j].resource.Identifier
+		})
+
+		report.Results = append(report.Results, destroyConcurrently(ctx, a, items, opts)...)
+	}
+
+	return report, report.Err()
+}
+
+type cleanupItem struct {
+	resource Resource
+	object   types.Object
+}
+
+func destroyConcurrently(ctx context.Context, a types.API, items []cleanupItem, opts CleanupOptions) []CleanupResult {
+	results := make([]CleanupResult, len(items))
+	semaphore := make(chan struct{}, opts.Concurrency)
+	wg := sync.WaitGroup{}
+
+	for i, item := range items {
+		results[i] = CleanupResult{Resource: item.resource, Status: CleanupStatusPlanned}
+		if opts.DryRun {
+			continue
+		}
+
+		if err := acquire(ctx, semaphore); err != nil {
+			results[i].Status = CleanupStatusFailed
+			results[i].Err = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, item cleanupItem) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := a.Destroy(ctx, item.object); api.IgnoreNotFound(err) != nil {
+				results[i].Status = CleanupStatusFailed
+				results[i].Err = err
+			} else {
+				results[i].Status = CleanupStatusDestroyed
+			}
+		}(i, item)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// acquire blocks until the semaphore is acquired or the context is done, returning the error of the context
+// in the latter case.
+func acquire(ctx context.Context, semaphore chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case semaphore <- struct{}{}:
+		return nil
+	}
+}
+
+// cleanupPhase returns the index of the order group containing the given type name, the index of the
+// OtherResourceTypes group for unlisted types or the index after the last group if there is none.
+func cleanupPhase(order [][]string, typeName string) int {
+	other := len(order)
+	for i, group := range order {
+		for _, t := range group {
+			if t == typeName {
+				return i
+			} else if t == OtherResourceTypes {
+				other = i
+			}
+		}
+	}
+
+	return other
+}
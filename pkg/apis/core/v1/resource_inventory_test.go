@@ -0,0 +1,111 @@
+package v1_test
+
+import (
+	"context"
+	"sync"
+
+	"go.anx.io/go-anxcloud/pkg/api/mock"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+	kubernetesv1 "go.anx.io/go-anxcloud/pkg/apis/kubernetes/v1"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// destroyRecorder records the identifiers of destroyed Objects in order.
+type destroyRecorder struct {
+	mock.API
+
+	mu        sync.Mutex
+	destroyed []string
+}
+
+func (r *destroyRecorder) Destroy(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+	identifier, _ := o.GetIdentifier(ctx)
+
+	r.mu.Lock()
+	r.destroyed = append(r.destroyed, identifier)
+	r.mu.Unlock()
+
+	return r.API.Destroy(ctx, o, opts...)
+}
+
+var _ = Describe("resource inventory", func() {
+	var (
+		a *destroyRecorder
+	)
+
+	BeforeEach(func() {
+		a = &destroyRecorder{API: mock.NewMockAPI()}
+
+		a.FakeExisting(&vlanv1.VLAN{Identifier: "vlan"}, "run-1", "ci")
+		a.FakeExisting(&lbaasv1.Backend{Identifier: "backend"}, "run-1", "ci")
+		a.FakeExisting(&lbaasv1.Frontend{Identifier: "frontend"}, "run-1", "ci")
+		a.FakeExisting(&lbaasv1.Server{Identifier: "server"}, "run-1")
+		a.FakeExisting(&lbaasv1.LoadBalancer{Identifier: "loadbalancer"}, "run-1", "ci")
+		a.FakeExisting(&vlanv1.VLAN{Identifier: "other-vlan"}, "run-2", "ci")
+	})
+
+	It("requires tags", func() {
+		_, err := corev1.ListResourcesByTags(context.TODO(), a, corev1.MatchAllTags)
+		Expect(err).To(MatchError(corev1.ErrNoTags))
+	})
+
+	DescribeTable("lists resources by tags",
+		func(match corev1.TagMatch, tags []string, expected []string) {
+			resources, err := corev1.ListResourcesByTags(context.TODO(), a, match, tags...)
+			Expect(err).NotTo(HaveOccurred())
+
+			identifiers := make([]string, 0, len(resources))
+			for _, r := range resources {
+				identifiers = append(identifiers, r.Identifier)
+			}
+			Expect(identifiers).To(ConsistOf(expected))
+		},
+		Entry("all tags", corev1.MatchAllTags, []string{"run-1", "ci"}, []string{"vlan", "backend", "frontend", "loadbalancer"}),
+		Entry("any tag", corev1.MatchAnyTag, []string{"run-2", "run-1"}, []string{"vlan", "backend", "frontend", "server", "loadbalancer", "other-vlan"}),
+	)
+
+	It("groups resources by type", func() {
+		resources, err := corev1.ListResourcesByTags(context.TODO(), a, corev1.MatchAnyTag, "ci")
+		Expect(err).NotTo(HaveOccurred())
+
+		groups := corev1.GroupByType(resources)
+		Expect(groups).To(HaveLen(4))
+		Expect(groups[corev1.Type{Identifier: "cf8e4dac56894afaa3244f6911bb62be"}]).To(HaveLen(2))
+	})
+
+	It("only reports resources in dry-run mode", func() {
+		report, err := corev1.CleanupResources(context.TODO(), a, corev1.CleanupOptions{Tags: []string{"run-1"}, DryRun: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Count(corev1.CleanupStatusPlanned)).To(Equal(5))
+		Expect(report.Count(corev1.CleanupStatusSkipped)).To(Equal(0))
+		Expect(a.destroyed).To(BeEmpty())
+	})
+
+	It("destroys resources in dependency order", func() {
+		a.FakeExisting(&kubernetesv1.Cluster{Identifier: "cluster"}, "run-1")
+
+		report, err := corev1.CleanupResources(context.TODO(), a, corev1.CleanupOptions{Tags: []string{"run-1"}, Concurrency: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.String()).To(Equal("6 destroyed, 0 planned, 0 skipped, 0 failed"))
+		Expect(a.destroyed).To(Equal([]string{"server", "frontend", "backend", "cluster", "loadbalancer", "vlan"}))
+		Expect(a.Inspect("other-vlan").Existing()).To(BeTrue())
+	})
+
+	It("stops destroying resources when the context is done", func() {
+		resources, err := corev1.ListResourcesByTags(context.TODO(), a, corev1.MatchAllTags, "run-2")
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		report, err := corev1.CleanupResources(ctx, a, corev1.CleanupOptions{Tags: []string{"run-2"}})
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(report.Count(corev1.CleanupStatusFailed)).To(Equal(len(resources)))
+		Expect(a.destroyed).To(BeEmpty())
+	})
+})
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// ResolveResource retrieves the typed Object represented by the given Resource, e.g. a *lbaasv1.Backend
+// or a *vlanv1.VLAN. The Object type has to be registered for the resource type with
+// api.RegisterResourceType, which the generated code of the packages in pkg/apis does on import. Resources
+// of other resource types are retrieved as each Object type registered with api.RegisterDiscoverableResource,
+// e.g. *lbaasv1.LoadBalancer or *kubernetesv1.Cluster, until one is found.
+// api.ErrUnknownResourceType is returned when no Object type is registered or found for the resource type.
+func ResolveResource(ctx context.Context, a api.API, res Resource) (types.Object, error) {
+	obj, err := api.NewObjectForResourceType(res.Type.Identifier, res.Identifier, res.Name)
+	if errors.Is(err, api.ErrUnknownResourceType) {
+		return discoverResource(ctx, a, res)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := a.Get(ctx, obj); err != nil {
+		return nil, fmt.Errorf("failed to retrieve resource %q: %w", res.Identifier, err)
+	}
+
+	return obj, nil
+}
+
+// newResourceObject returns a new Object for the given Resource, discovering its Object type when no Object type
+// is registered for its resource type.
+func newResourceObject(ctx context.Context, a api.API, res Resource) (types.Object, error) {
+	obj, err := api.NewObjectForResourceType(res.Type.Identifier, res.Identifier, res.Name)
+	if errors.Is(err, api.ErrUnknownResourceType) {
+		return discoverResource(ctx, a, res)
+	}
+
+	return obj, err
+}
+
+// discoverResource retrieves the given Resource as each discoverable Object type, returning the first one found
+// and registering its Object type for the resource type.
+func discoverResource(ctx context.Context, a api.API, res Resource) (types.Object, error) {
+	for _, obj := range api.DiscoverableResourceObjects(res.Identifier, res.Name) {
+		err := a.Get(ctx, obj)
+		if err == nil {
+			if res.Type.Identifier != "" {
+				api.RegisterDiscoveredResourceType(res.Type.Identifier, obj)
+			}
+
+			return obj, nil
+		} else if api.IgnoreNotFound(err) != nil && !errors.Is(err, api.ErrOperationNotSupported) {
+			return nil, fmt.Errorf("failed to retrieve resource %q: %w", res.Identifier, err)
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", api.ErrUnknownResourceType, res.Type.Identifier)
+}
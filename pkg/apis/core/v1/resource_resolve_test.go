@@ -0,0 +1,109 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+	kubernetesv1 "go.anx.io/go-anxcloud/pkg/apis/kubernetes/v1"
+	lbaasv1 "go.anx.io/go-anxcloud/pkg/apis/lbaas/v1"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ResolveResource", func() {
+	var (
+		a   api.API
+		srv *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("retrieves the typed object of a registered resource type", func() {
+		typeIdentifier, ok := api.ResourceTypeOf(&vlanv1.VLAN{})
+		Expect(ok).To(BeTrue())
+
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/vlan/v1/vlan.json/vlan-id"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+				"identifier":           "vlan-id",
+				"description_customer": "resolved",
+			}),
+		))
+
+		obj, err := corev1.ResolveResource(context.TODO(), a, corev1.Resource{
+			Identifier: "vlan-id",
+			Type:       corev1.Type{Identifier: typeIdentifier},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&vlanv1.VLAN{}))
+		Expect(obj.(*vlanv1.VLAN).DescriptionCustomer).To(Equal("resolved"))
+	})
+
+	It("discovers the typed object of resource types with unknown identifier", func() {
+		srv.SetAllowUnhandledRequests(true)
+		srv.SetUnhandledRequestStatusCode(http.StatusNotFound)
+
+		var loadBalancerRequests int
+		srv.RouteToHandler("GET", "/api/LBaaS/v1/loadbalancer.json/lb-id", ghttp.CombineHandlers(
+			func(http.ResponseWriter, *http.Request) { loadBalancerRequests++ },
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "lb-id", "name": "resolved-lb"}),
+		))
+		srv.RouteToHandler("GET", "/api/kubernetes/v1/cluster.json/cluster-id", ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+			"identifier": "cluster-id",
+			"name":       "resolved-cluster",
+		}))
+
+		loadBalancer := corev1.Resource{Identifier: "lb-id", Type: corev1.Type{Identifier: "discovered-loadbalancer-type"}}
+
+		obj, err := corev1.ResolveResource(context.TODO(), a, loadBalancer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&lbaasv1.LoadBalancer{}))
+		Expect(obj.(*lbaasv1.LoadBalancer).Name).To(Equal("resolved-lb"))
+
+		obj, err = corev1.ResolveResource(context.TODO(), a, corev1.Resource{
+			Identifier: "cluster-id",
+			Type:       corev1.Type{Identifier: "discovered-cluster-type"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&kubernetesv1.Cluster{}))
+		Expect(obj.(*kubernetesv1.Cluster).Name).To(Equal("resolved-cluster"))
+
+		By("remembering the discovered resource type")
+		typeIdentifier, ok := api.ResourceTypeOf(&lbaasv1.LoadBalancer{})
+		Expect(ok).To(BeTrue())
+		Expect(typeIdentifier).To(Equal("discovered-loadbalancer-type"))
+
+		requests := len(srv.ReceivedRequests())
+		_, err = corev1.ResolveResource(context.TODO(), a, loadBalancer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(srv.ReceivedRequests()).To(HaveLen(requests + 1))
+		Expect(loadBalancerRequests).To(Equal(2))
+	})
+
+	It("returns an error for unknown resource types", func() {
+		srv.SetAllowUnhandledRequests(true)
+		srv.SetUnhandledRequestStatusCode(http.StatusNotFound)
+
+		_, err := corev1.ResolveResource(context.TODO(), a, corev1.Resource{
+			Identifier: "some-id",
+			Type:       corev1.Type{Identifier: "unknown-type"},
+		})
+		Expect(err).To(MatchError(api.ErrUnknownResourceType))
+	})
+})
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"sort"
+	"strings"
+)
+
+// LabelSeparator separates key and value of tags used as labels, e.g. "env=production".
+const LabelSeparator = "="
+
+// FormatLabel returns the tag for the given key and value.
+func FormatLabel(key, value string) string {
+	return key + LabelSeparator + value
+}
+
+// ParseLabel splits a tag into key and value. The tag is split at the first separator, keys containing
+// the separator cannot be represented. ok is false for tags not following the key=value convention.
+func ParseLabel(tag string) (key, value string, ok bool) {
+	key, value, ok = strings.Cut(tag, LabelSeparator)
+	if !ok || key == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// Labels extracts all key=value tags into a map, tags not following the convention are ignored.
+// When a key is given multiple times, the last value wins.
+func Labels(tags []string) map[string]string {
+	labels := make(map[string]string)
+	for _, tag := range tags {
+		if key, value, ok := ParseLabel(tag); ok {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// LabelTags returns the tags for the given labels, sorted for stable results.
+func LabelTags(labels map[string]string) []string {
+	tags := make([]string, 0, len(labels))
+	for key, value := range labels {
+		tags = append(tags, FormatLabel(key, value))
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// SetLabels returns a copy of tags with the given labels set, replacing any other value of the same keys.
+// Tags not following the key=value convention are kept as-is. The result can be passed to SyncTags.
+func SetLabels(tags []string, labels map[string]string) []string {
+	ret := make([]string, 0, len(tags)+len(labels))
+	for _, tag := range tags {
+		if key, _, ok := ParseLabel(tag); ok {
+			if _, replaced := labels[key]; replaced {
+				continue
+			}
+		}
+		ret = append(ret, tag)
+	}
+	return append(ret, LabelTags(labels)...)
+}
+
+// RemoveLabels returns a copy of tags without the labels with the given keys.
+func RemoveLabels(tags []string, keys ...string) []string {
+	ret := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if key, _, ok := ParseLabel(tag); ok && containsString(keys, key) {
+			continue
+		}
+		ret = append(ret, tag)
+	}
+	return ret
+}
+
+// MatchLabels checks if the tags contain all the given labels. Labels with an empty value only
+// require the key to be present.
+func MatchLabels(tags []string, selector map[string]string) bool {
+	labels := Labels(tags)
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || (value != "" && actual != value) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterByLabels returns the resources whose tags contain all the given labels, see MatchLabels.
+// Resources returned by List only contain tags when retrieved with api.FullObjects.
+func FilterByLabels(resources []Resource, selector map[string]string) []Resource {
+	ret := make([]Resource, 0, len(resources))
+	for _, r := range resources {
+		if MatchLabels(r.Tags, selector) {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package v1_test
+
+import (
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tag labels", func() {
+	It("formats and parses labels", func() {
+		tag := corev1.FormatLabel("env", "production")
+		Expect(tag).To(Equal("env=production"))
+
+		key, value, ok := corev1.ParseLabel(tag)
+		Expect(ok).To(BeTrue())
+		Expect(key).To(Equal("env"))
+		Expect(value).To(Equal("production"))
+	})
+
+	DescribeTable("ParseLabel",
+		func(tag, key, value string, ok bool) {
+			actualKey, actualValue, actualOk := corev1.ParseLabel(tag)
+			Expect(actualKey).To(Equal(key))
+			Expect(actualValue).To(Equal(value))
+			Expect(actualOk).To(Equal(ok))
+		},
+		Entry("plain tag", "foo", "", "", false),
+		Entry("empty value", "foo=", "foo", "", true),
+		Entry("value containing separator", "foo=bar=baz", "foo", "bar=baz", true),
+		Entry("empty key", "=bar", "", "", false),
+	)
+
+	It("converts between tags and labels", func() {
+		tags := []string{"plain", "env=staging", "team=core", "env=production"}
+
+		Expect(corev1.Labels(tags)).To(Equal(map[string]string{"env": "production", "team": "core"}))
+		Expect(corev1.LabelTags(map[string]string{"team": "core", "env": "production"})).To(Equal([]string{"env=production", "team=core"}))
+	})
+
+	It("sets and removes labels", func() {
+		tags := []string{"plain", "env=staging", "team=core"}
+
+		Expect(corev1.SetLabels(tags, map[string]string{"env": "production"})).To(Equal([]string{"plain", "team=core", "env=production"}))
+		Expect(corev1.RemoveLabels(tags, "team")).To(Equal([]string{"plain", "env=staging"}))
+	})
+
+	It("matches and filters by labels", func() {
+		tags := []string{"plain", "env=production", "team=core"}
+
+		Expect(corev1.MatchLabels(tags, map[string]string{"env": "production"})).To(BeTrue())
+		Expect(corev1.MatchLabels(tags, map[string]string{"env": "production", "team": ""})).To(BeTrue())
+		Expect(corev1.MatchLabels(tags, map[string]string{"env": "staging"})).To(BeFalse())
+		Expect(corev1.MatchLabels(tags, map[string]string{"owner": ""})).To(BeFalse())
+
+		resources := []corev1.Resource{
+			{Identifier: "a", Tags: tags},
+			{Identifier: "b", Tags: []string{"env=staging"}},
+		}
+		Expect(corev1.FilterByLabels(resources, map[string]string{"env": "production"})).To(HaveExactElements(
+			HaveField("Identifier", "a"),
+		))
+	})
+})
@@ -20,7 +20,7 @@ func Tag(ctx context.Context, a types.API, obj types.IdentifiedObject, tags ...s
 		return fmt.Errorf("generating ResourceWithTag objects failed: %w", err)
 	}
 	for _, obj := range objects {
-		tag := func() (bool, error) {
+		tag := func(ctx context.Context) (bool, error) {
 			var (
 				httpError api.HTTPError
 				retryable bool
@@ -37,7 +37,7 @@ func Tag(ctx context.Context, a types.API, obj types.IdentifiedObject, tags ...s
 			return retryable, err
 		}
 
-		if err := retry.Retry(ctx, 3, time.Second, tag); err != nil {
+		if err := retry.RetryWithContext(ctx, 3, time.Second, tag); err != nil {
 			return err
 		}
 	}
@@ -90,6 +90,58 @@ func ListTags(ctx context.Context, a types.API, obj types.IdentifiedObject) ([]s
 	return r.Tags, nil
 }
 
+// SyncTags makes the tags of the given object match the desired tags, adding missing and removing
+// surplus tags. Only the minimal set of changes is applied, tags already present are not touched.
+func SyncTags(ctx context.Context, a types.API, obj types.IdentifiedObject, desired ...string) error {
+	current, err := ListTags(ctx, a, obj)
+	if err != nil {
+		return fmt.Errorf("failed listing current tags: %w", err)
+	}
+
+	add, remove := DiffTags(current, desired)
+
+	if len(add) > 0 {
+		if err := Tag(ctx, a, obj, add...); err != nil {
+			return fmt.Errorf("failed adding tags: %w", err)
+		}
+	}
+
+	if len(remove) > 0 {
+		if err := Untag(ctx, a, obj, remove...); err != nil {
+			return fmt.Errorf("failed removing tags: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DiffTags computes the tags to add and to remove to get from the current to the desired tags.
+// Duplicates are ignored and both returned lists keep the order of their input.
+func DiffTags(current, desired []string) (add, remove []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, tag := range current {
+		currentSet[tag] = true
+	}
+
+	desiredSet := make(map[string]bool, len(desired))
+	for _, tag := range desired {
+		if !desiredSet[tag] && !currentSet[tag] {
+			add = append(add, tag)
+		}
+		desiredSet[tag] = true
+	}
+
+	seen := make(map[string]bool, len(current))
+	for _, tag := range current {
+		if !seen[tag] && !desiredSet[tag] {
+			remove = append(remove, tag)
+		}
+		seen[tag] = true
+	}
+
+	return add, remove
+}
+
 type taggerImplementation int
 
 func (ti taggerImplementation) Tag(ctx context.Context, a types.API, obj types.IdentifiedObject, tags ...string) error {
@@ -104,6 +156,10 @@ func (ti taggerImplementation) ListTags(ctx context.Context, a types.API, obj ty
 	return ListTags(ctx, a, obj)
 }
 
+func (ti taggerImplementation) SyncTags(ctx context.Context, a types.API, obj types.IdentifiedObject, desired ...string) error {
+	return SyncTags(ctx, a, obj, desired...)
+}
+
 func init() {
 	// This is a workaround to solve import cycles between `pkg/apis/core/v1` <--> `pkg/api`
 	// initially caused by the AutoTag Create option.
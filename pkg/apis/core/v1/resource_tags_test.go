@@ -0,0 +1,170 @@
+package v1_test
+
+import (
+	"context"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/mock"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("tag sync", func() {
+	DescribeTable("DiffTags",
+		func(current, desired, add, remove []string) {
+			actualAdd, actualRemove := corev1.DiffTags(current, desired)
+			Expect(actualAdd).To(Equal(add))
+			Expect(actualRemove).To(Equal(remove))
+		},
+		Entry("nothing to do", []string{"a", "b"}, []string{"b", "a"}, nil, nil),
+		Entry("only additions", []string{"a"}, []string{"a", "b", "c"}, []string{"b", "c"}, nil),
+		Entry("only removals", []string{"a", "b", "c"}, []string{"b"}, nil, []string{"a", "c"}),
+		Entry("additions and removals", []string{"a", "b"}, []string{"b", "c"}, []string{"c"}, []string{"a"}),
+		Entry("ignores duplicates", []string{"a", "a"}, []string{"b", "b"}, []string{"b"}, []string{"a"}),
+		Entry("remove all", []string{"a", "b"}, nil, nil, []string{"a", "b"}),
+	)
+
+	Context("with mock API", func() {
+		var (
+			a    mock.API
+			vlan *vlanv1.VLAN
+		)
+
+		BeforeEach(func() {
+			a = mock.NewMockAPI()
+			vlan = &vlanv1.VLAN{}
+			a.FakeExisting(vlan, "keep", "remove")
+		})
+
+		It("applies the minimal changes", func() {
+			Expect(corev1.SyncTags(context.TODO(), a, vlan, "keep", "add")).To(Succeed())
+			Expect(a.Inspect(vlan.Identifier).Tags()).To(ConsistOf("keep", "add"))
+
+			tags, err := corev1.ListTags(context.TODO(), a, vlan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tags).To(Equal([]string{"add", "keep"}))
+		})
+
+		It("removes all tags when no tags are desired", func() {
+			Expect(corev1.SyncTags(context.TODO(), a, vlan)).To(Succeed())
+			Expect(a.Inspect(vlan.Identifier).Tags()).To(BeEmpty())
+		})
+
+		It("syncs tags on Update with ManagedTags", func() {
+			Expect(a.Update(context.TODO(), vlan, api.ManagedTags("keep", "add"))).To(Succeed())
+			Expect(a.Inspect(vlan.Identifier).Tags()).To(ConsistOf("keep", "add"))
+		})
+
+		It("keeps tags on Update without ManagedTags", func() {
+			Expect(a.Update(context.TODO(), vlan)).To(Succeed())
+			Expect(a.Inspect(vlan.Identifier).Tags()).To(ConsistOf("keep", "remove"))
+		})
+	})
+
+	Context("with real API", func() {
+		var (
+			a   api.API
+			srv *ghttp.Server
+		)
+
+		BeforeEach(func() {
+			srv = ghttp.NewServer()
+
+			var err error
+			a, err = api.NewAPI(api.WithClientOptions(
+				client.BaseURL(srv.URL()),
+				client.IgnoreMissingToken(),
+			))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+		})
+
+		It("syncs tags after updating an object with ManagedTags", func() {
+			srv.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/vlan/v1/vlan.json/test-vlan"),
+					ghttp.RespondWithJSONEncoded(200, map[string]any{"identifier": "test-vlan"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/core/v1/resource.json/test-vlan"),
+					ghttp.RespondWithJSONEncoded(200, map[string]any{
+						"identifier": "test-vlan",
+						"tags": []map[string]any{
+							{"name": "keep", "identifier": "tag-1"},
+							{"name": "remove", "identifier": "tag-2"},
+						},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/core/v1/resource.json/test-vlan/tags/add"),
+					ghttp.RespondWith(200, ""),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/core/v1/resource.json/test-vlan/tags/remove"),
+					ghttp.RespondWith(200, ""),
+				),
+			)
+
+			err := a.Update(context.TODO(), &vlanv1.VLAN{Identifier: "test-vlan"}, api.ManagedTags("keep", "add"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(srv.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("removes tags of protected objects with ManagedTags", func() {
+			srv.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/vlan/v1/vlan.json/test-vlan"),
+					ghttp.RespondWithJSONEncoded(200, map[string]any{"identifier": "test-vlan"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/core/v1/resource.json/test-vlan"),
+					ghttp.RespondWithJSONEncoded(200, map[string]any{
+						"identifier": "test-vlan",
+						"tags":       []map[string]any{{"name": "remove", "identifier": "tag-1"}},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/core/v1/resource.json/test-vlan/tags/remove"),
+					ghttp.RespondWith(200, ""),
+				),
+			)
+
+			protected, err := api.NewAPI(
+				api.WithClientOptions(
+					client.BaseURL(srv.URL()),
+					client.IgnoreMissingToken(),
+				),
+				api.WithProtection(api.ProtectionPolicy{Rules: []api.ProtectionRule{api.ProtectIdentifiers("test-vlan")}}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(protected.Update(context.TODO(), &vlanv1.VLAN{Identifier: "test-vlan"}, api.ManagedTags())).To(Succeed())
+			Expect(srv.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("returns ErrTaggingFailed when syncing tags fails", func() {
+			srv.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/vlan/v1/vlan.json/test-vlan"),
+					ghttp.RespondWithJSONEncoded(200, map[string]any{"identifier": "test-vlan"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/core/v1/resource.json/test-vlan"),
+					ghttp.RespondWith(404, ""),
+				),
+			)
+
+			err := a.Update(context.TODO(), &vlanv1.VLAN{Identifier: "test-vlan"}, api.ManagedTags("keep"))
+			var taggingErr *api.ErrTaggingFailed
+			Expect(err).To(BeAssignableToTypeOf(taggingErr))
+		})
+	})
+})
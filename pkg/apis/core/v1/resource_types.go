@@ -8,7 +8,7 @@ type Type struct {
 	Name       string `json:"name"`
 }
 
-// anxcloud:object:hooks=ResponseDecodeHook
+// anxcloud:object:hooks=ResponseDecodeHook:nocontract
 
 // Resource contains all information about a resource.
 type Resource struct {
@@ -21,7 +21,7 @@ type Resource struct {
 	Attributes json.RawMessage `json:"attributes"`
 }
 
-// anxcloud:object:hooks=ResponseFilterHook,RequestBodyHook,FilterRequestURLHook
+// anxcloud:object:hooks=ResponseFilterHook,RequestBodyHook,FilterRequestURLHook:nocontract
 
 // ResourceWithTag is a virtual Object used to add (Create) or remove (Destroy) a tag to/from a Resource.
 type ResourceWithTag struct {
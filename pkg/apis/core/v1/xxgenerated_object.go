@@ -4,6 +4,7 @@ package v1
 
 import (
 	"context"
+	"slices"
 )
 
 // GetIdentifier returns the primary identifier of a Resource object
@@ -15,3 +16,194 @@ func (o *Resource) GetIdentifier(ctx context.Context) (string, error) {
 func (o *ResourceWithTag) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the Location object. Values stored in interface fields are copied shallowly.
+func (o *Location) DeepCopy() *Location {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Latitude != nil {
+		v1 := *out.Latitude
+		out.Latitude = &v1
+	}
+	if out.Longitude != nil {
+		v1 := *out.Longitude
+		out.Longitude = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Location object is equal to the given one, comparing all fields deeply.
+func (o *Location) Equal(other *Location) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Code != other.Code {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.CountryCode != other.CountryCode {
+		return false
+	}
+	if o.CityCode != other.CityCode {
+		return false
+	}
+	if (o.Latitude == nil) != (other.Latitude == nil) {
+		return false
+	}
+	if o.Latitude != nil {
+		if (*o.Latitude) != (*other.Latitude) {
+			return false
+		}
+	}
+	if (o.Longitude == nil) != (other.Longitude == nil) {
+		return false
+	}
+	if o.Longitude != nil {
+		if (*o.Longitude) != (*other.Longitude) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LocationFields contains the names of the fields of Location objects, usable with compare.Compare and compare.Reconcile.
+var LocationFields = struct {
+	Identifier  string
+	Code        string
+	Name        string
+	CountryCode string
+	CityCode    string
+	Latitude    string
+	Longitude   string
+}{
+	Identifier:  "Identifier",
+	Code:        "Code",
+	Name:        "Name",
+	CountryCode: "CountryCode",
+	CityCode:    "CityCode",
+	Latitude:    "Latitude",
+	Longitude:   "Longitude",
+}
+
+// DeepCopy returns a deep copy of the Resource object. Values stored in interface fields are copied shallowly.
+func (o *Resource) DeepCopy() *Resource {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	out.Attributes = slices.Clone(out.Attributes)
+
+	return &out
+}
+
+// Equal returns if the Resource object is equal to the given one, comparing all fields deeply.
+func (o *Resource) Equal(other *Resource) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Type.Identifier != other.Type.Identifier {
+		return false
+	}
+	if o.Type.Name != other.Type.Name {
+		return false
+	}
+	if o.CreatedAt != other.CreatedAt {
+		return false
+	}
+	if o.UpdatedAt != other.UpdatedAt {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1] != other.Tags[i1] {
+			return false
+		}
+	}
+	if (o.Attributes == nil) != (other.Attributes == nil) || len(o.Attributes) != len(other.Attributes) {
+		return false
+	}
+	for i1 := range o.Attributes {
+		if o.Attributes[i1] != other.Attributes[i1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ResourceFields contains the names of the fields of Resource objects, usable with compare.Compare and compare.Reconcile.
+var ResourceFields = struct {
+	Identifier string
+	Name       string
+	Type       string
+	CreatedAt  string
+	UpdatedAt  string
+	Tags       string
+	Attributes string
+}{
+	Identifier: "Identifier",
+	Name:       "Name",
+	Type:       "Type",
+	CreatedAt:  "CreatedAt",
+	UpdatedAt:  "UpdatedAt",
+	Tags:       "Tags",
+	Attributes: "Attributes",
+}
+
+// DeepCopy returns a deep copy of the ResourceWithTag object. Values stored in interface fields are copied shallowly.
+func (o *ResourceWithTag) DeepCopy() *ResourceWithTag {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+
+	return &out
+}
+
+// Equal returns if the ResourceWithTag object is equal to the given one, comparing all fields deeply.
+func (o *ResourceWithTag) Equal(other *ResourceWithTag) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Tag != other.Tag {
+		return false
+	}
+
+	return true
+}
+
+// ResourceWithTagFields contains the names of the fields of ResourceWithTag objects, usable with compare.Compare and compare.Reconcile.
+var ResourceWithTagFields = struct {
+	Identifier string
+	Tag        string
+}{
+	Identifier: "Identifier",
+	Tag:        "Tag",
+}
@@ -20,6 +20,8 @@ var _ = Describe("Object Location", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.LocationFields)
 })
 
 var _ = Describe("Object Resource", func() {
@@ -36,6 +38,8 @@ var _ = Describe("Object Resource", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ResourceFields)
 })
 
 var _ = Describe("Object ResourceWithTag", func() {
@@ -60,4 +64,6 @@ var _ = Describe("Object ResourceWithTag", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ResourceWithTagFields)
 })
@@ -0,0 +1,323 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+)
+
+// DefaultIgnoreFile is the name of the file listing paths to exclude when packaging a source directory.
+const DefaultIgnoreFile = ".e5eignore"
+
+// defaultDeploymentPollInterval is the interval in which a Function is retrieved while waiting for its deployment.
+const defaultDeploymentPollInterval = 5 * time.Second
+
+// ErrDeploymentFailed is returned (wrapped in a *DeploymentFailedError) when the deployment of a Function failed.
+var ErrDeploymentFailed = errors.New("function deployment failed")
+
+// DeploymentFailedError is returned by DeployFunction when the e5e platform reports the deployment as failed.
+type DeploymentFailedError struct {
+	// Function is the identifier of the function that failed to deploy.
+	Function string
+
+	// State is the deployment state reported for the function.
+	State string
+
+	// Errors are the build errors reported for the function, if any.
+	Errors []DeploymentError
+}
+
+func (e *DeploymentFailedError) Error() string {
+	msg := fmt.Sprintf("%v: function %q in deployment state %q", ErrDeploymentFailed, e.Function, e.State)
+
+	for _, de := range e.Errors {
+		msg += "\n" + de.String()
+	}
+
+	return msg
+}
+
+// Is makes errors.Is(err, ErrDeploymentFailed) work for *DeploymentFailedError.
+func (e *DeploymentFailedError) Is(target error) bool {
+	return target == ErrDeploymentFailed
+}
+
+// String formats the DeploymentError as "file:line: message", omitting missing location parts.
+func (de DeploymentError) String() string {
+	switch {
+	case de.File != "" && de.Line > 0:
+		return fmt.Sprintf("%s:%d: %s", de.File, de.Line, de.Message)
+	case de.File != "":
+		return fmt.Sprintf("%s: %s", de.File, de.Message)
+	default:
+		return de.Message
+	}
+}
+
+// DeploymentOptions configures DeployFunction.
+type DeploymentOptions struct {
+	// IgnoreFile is the path of the ignore file relative to the source directory, DefaultIgnoreFile if empty.
+	IgnoreFile string
+
+	// ArchiveName is the file name of the uploaded archive, the name of the source directory with ".zip" appended if empty.
+	ArchiveName string
+
+	// PollInterval is the interval in which the deployment state is checked, 5 seconds if zero.
+	PollInterval time.Duration
+}
+
+// PackageDirectory creates a zip archive of all files in dir, excluding the ".git" directory and paths matched
+// by the patterns in the given ignore file (relative to dir). A missing ignore file is not an error. Patterns
+// follow a subset of the .gitignore syntax: blank lines and lines starting with "#" are skipped, patterns
+// ending with "/" only match directories, patterns containing a "/" are matched against the path relative to
+// dir and all others against the name of every path element.
+func PackageDirectory(dir, ignoreFile string) ([]byte, error) {
+	patterns, err := readIgnoreFile(filepath.Join(dir, ignoreFile))
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	w := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		} else if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.Name() == ".git" || ignored(patterns, rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = rel
+		header.Method = zip.Deflate
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to package directory %q: %w", dir, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// NewStorageBackendMetaArchive packages dir with PackageDirectory and encodes it as archive storage backend.
+func NewStorageBackendMetaArchive(dir, ignoreFile, name string) (*StorageBackendMetaArchive, error) {
+	data, err := PackageDirectory(dir, ignoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageBackendMetaArchive{
+		Content: "data:application/zip;base64," + base64.StdEncoding.EncodeToString(data),
+		Name:    name,
+	}, nil
+}
+
+// DeployFunction packages the source directory dir into an archive, configures it as storage backend of the
+// given Function and creates it (when it has no identifier yet) or updates it. It then waits until the
+// deployment finished, returning a *DeploymentFailedError containing the reported build errors if the
+// deployment failed. After updating a Function, a finished state retrieved right away is ignored unless the
+// update response reported the new deployment to be in progress, as the Function might still be returned with
+// the state of the previous deployment. The state retrieved with the next poll is accepted.
+func DeployFunction(ctx context.Context, a api.API, fn *Function, dir string, opts DeploymentOptions) error {
+	if opts.IgnoreFile == "" {
+		opts.IgnoreFile = DefaultIgnoreFile
+	}
+
+	if opts.ArchiveName == "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		opts.ArchiveName = filepath.Base(abs) + ".zip"
+	}
+
+	archive, err := NewStorageBackendMetaArchive(dir, opts.IgnoreFile, opts.ArchiveName)
+	if err != nil {
+		return err
+	}
+
+	fn.StorageBackend = StorageBackendArchive
+	fn.StorageBackendMeta = &StorageBackendMeta{StorageBackendMetaArchive: archive}
+
+	if fn.Identifier == "" {
+		if err := a.Create(ctx, fn); err != nil {
+			return fmt.Errorf("failed to create function: %w", err)
+		}
+
+		return AwaitDeployment(ctx, a, fn, opts.PollInterval)
+	}
+
+	// clear the state of the previous deployment, the update response might not contain it
+	fn.DeploymentState = ""
+	if err := a.Update(ctx, fn); err != nil {
+		return fmt.Errorf("failed to update function: %w", err)
+	}
+
+	// without a deployment in progress in the update response, the first retrieved state might still be the one of
+	// the previous deployment
+	inProgress := fn.DeploymentState != "" && !deploymentFinished(fn.DeploymentState)
+	return awaitDeployment(ctx, a, fn, opts.PollInterval, !inProgress)
+}
+
+// AwaitDeployment blocks until the deployment of the given Function finished, polling in the given interval
+// (5 seconds if zero). Returns a *DeploymentFailedError if the deployment failed.
+func AwaitDeployment(ctx context.Context, a api.API, fn *Function, interval time.Duration) error {
+	return awaitDeployment(ctx, a, fn, interval, false)
+}
+
+// awaitDeployment implements AwaitDeployment, ignoring a finished state retrieved with the first poll if
+// skipFirstFinished is set.
+func awaitDeployment(ctx context.Context, a api.API, fn *Function, interval time.Duration, skipFirstFinished bool) error {
+	if interval <= 0 {
+		interval = defaultDeploymentPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		if err := a.Get(ctx, fn); err != nil {
+			return fmt.Errorf("failed to get function: %w", err)
+		}
+
+		if deploymentFinished(fn.DeploymentState) && (!first || !skipFirstFinished) {
+			if fn.DeploymentState == DeploymentStateSuccess {
+				return nil
+			}
+
+			return &DeploymentFailedError{
+				Function: fn.Identifier,
+				State:    fn.DeploymentState,
+				Errors:   fn.DeploymentErrors,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
+
+func deploymentFinished(state string) bool {
+	switch state {
+	case DeploymentStateSuccess, DeploymentStateFailed, DeploymentStateError:
+		return true
+	}
+
+	return false
+}
+
+type ignorePattern struct {
+	pattern string
+	dirOnly bool
+}
+
+func readIgnoreFile(p string) ([]ignorePattern, error) {
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open ignore file: %w", err)
+	}
+	defer f.Close()
+
+	var patterns []ignorePattern
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ip := ignorePattern{pattern: strings.TrimPrefix(line, "/")}
+		if strings.HasSuffix(ip.pattern, "/") {
+			ip.dirOnly = true
+			ip.pattern = strings.TrimSuffix(ip.pattern, "/")
+		}
+
+		if _, err := path.Match(ip.pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q in ignore file: %w", line, err)
+		}
+
+		patterns = append(patterns, ip)
+	}
+
+	return patterns, s.Err()
+}
+
+func ignored(patterns []ignorePattern, rel string, isDir bool) bool {
+	for _, ip := range patterns {
+		if ip.dirOnly && !isDir {
+			continue
+		}
+
+		name := rel
+		if !strings.Contains(ip.pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if ok, _ := path.Match(ip.pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,237 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+func writeSourceTree(files map[string]string) string {
+	dir := GinkgoT().TempDir()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(p), 0o755)).To(Succeed())
+		Expect(os.WriteFile(p, []byte(content), 0o600)).To(Succeed())
+	}
+
+	return dir
+}
+
+func archiveFiles(data []byte) map[string]string {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	Expect(err).NotTo(HaveOccurred())
+
+	files := make(map[string]string, len(r.File))
+	for _, f := range r.File {
+		rc, err := f.Open()
+		Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(rc)
+		Expect(err).NotTo(HaveOccurred())
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+
+	return files
+}
+
+var _ = Describe("PackageDirectory", func() {
+	It("packages all files except ignored ones", func() {
+		dir := writeSourceTree(map[string]string{
+			"main.py":             "print('hello')",
+			"lib/util.py":         "pass",
+			"lib/util.pyc":        "compiled",
+			"node_modules/x/a.js": "ignored",
+			"build/out.txt":       "ignored",
+			"docs/build":          "kept, not a directory",
+			".git/HEAD":           "ref",
+			DefaultIgnoreFile:     "# comment\n\n*.pyc\nnode_modules\nbuild/\n",
+		})
+
+		data, err := PackageDirectory(dir, DefaultIgnoreFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archiveFiles(data)).To(Equal(map[string]string{
+			"main.py":         "print('hello')",
+			"lib/util.py":     "pass",
+			"docs/build":      "kept, not a directory",
+			DefaultIgnoreFile: "# comment\n\n*.pyc\nnode_modules\nbuild/\n",
+		}))
+	})
+
+	It("does not require an ignore file", func() {
+		dir := writeSourceTree(map[string]string{"main.py": "print('hello')"})
+
+		archive, err := NewStorageBackendMetaArchive(dir, DefaultIgnoreFile, "src.zip")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archive.Name).To(Equal("src.zip"))
+		Expect(archive.Content).To(HavePrefix("data:application/zip;base64,"))
+
+		data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(archive.Content, "data:application/zip;base64,"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archiveFiles(data)).To(HaveKey("main.py"))
+	})
+})
+
+var _ = Describe("DeployFunction", func() {
+	var (
+		engine api.API
+		srv    *ghttp.Server
+		dir    string
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		engine, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+
+		dir = writeSourceTree(map[string]string{"main.py": "print('hello')"})
+	})
+
+	verifyArchiveUpload := func(_ http.ResponseWriter, req *http.Request) {
+		body := struct {
+			StorageBackend     string `json:"storage_backend"`
+			StorageBackendMeta struct {
+				Archive StorageBackendMetaArchive `json:"archive_file"`
+			} `json:"storage_backend_meta"`
+		}{}
+		Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+		Expect(body.StorageBackend).To(Equal(StorageBackendArchive))
+		Expect(body.StorageBackendMeta.Archive.Name).To(Equal("function.zip"))
+		Expect(body.StorageBackendMeta.Archive.Content).To(HavePrefix("data:application/zip;base64,"))
+	}
+
+	It("creates the function and waits for the deployment", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/e5e/v1/function.json"),
+				verifyArchiveUpload,
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "pending"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "success"}),
+			),
+		)
+
+		fn := Function{Name: "foo"}
+		err := DeployFunction(context.TODO(), engine, &fn, dir, DeploymentOptions{ArchiveName: "function.zip"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fn.Identifier).To(Equal("fn-id"))
+		Expect(fn.DeploymentState).To(Equal(DeploymentStateSuccess))
+	})
+
+	It("updates existing functions and returns build errors", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/e5e/v1/function.json/fn-id"),
+				verifyArchiveUpload,
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "building"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+					"identifier":       "fn-id",
+					"deployment_state": "failed",
+					"deployment_errors": []map[string]any{
+						{"message": "invalid syntax", "file": "main.py", "line": 1},
+					},
+				}),
+			),
+		)
+
+		fn := Function{Identifier: "fn-id"}
+		err := DeployFunction(context.TODO(), engine, &fn, dir, DeploymentOptions{ArchiveName: "function.zip", PollInterval: time.Millisecond})
+		Expect(err).To(MatchError(ErrDeploymentFailed))
+
+		var deployErr *DeploymentFailedError
+		Expect(err).To(BeAssignableToTypeOf(deployErr))
+		deployErr = err.(*DeploymentFailedError)
+		Expect(deployErr.Errors).To(ConsistOf(DeploymentError{Message: "invalid syntax", File: "main.py", Line: 1}))
+		Expect(err.Error()).To(ContainSubstring("main.py:1: invalid syntax"))
+	})
+
+	It("ignores the state of the previous deployment after updating", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/e5e/v1/function.json/fn-id"),
+				verifyArchiveUpload,
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "success"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "building"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": "success"}),
+			),
+		)
+
+		fn := Function{Identifier: "fn-id", DeploymentState: DeploymentStateSuccess}
+		err := DeployFunction(context.TODO(), engine, &fn, dir, DeploymentOptions{ArchiveName: "function.zip", PollInterval: time.Millisecond})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fn.DeploymentState).To(Equal(DeploymentStateSuccess))
+		Expect(srv.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	DescribeTable("returns deployments finished before the first poll after updating",
+		func(state string, expectedErr error) {
+			finished := ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id", "deployment_state": state}),
+			)
+
+			srv.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/e5e/v1/function.json/fn-id"),
+					verifyArchiveUpload,
+					ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "fn-id"}),
+				),
+				finished,
+				finished,
+			)
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+			defer cancel()
+
+			fn := Function{Identifier: "fn-id"}
+			err := DeployFunction(ctx, engine, &fn, dir, DeploymentOptions{ArchiveName: "function.zip", PollInterval: time.Millisecond})
+			if expectedErr == nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+			Expect(srv.ReceivedRequests()).To(HaveLen(3))
+		},
+		Entry("success", DeploymentStateSuccess, nil),
+		Entry("failed", DeploymentStateFailed, ErrDeploymentFailed),
+	)
+})
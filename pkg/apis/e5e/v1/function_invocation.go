@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+)
+
+// defaultAsyncResultPollInterval is the interval in which the result of an asynchronous execution is retrieved.
+const defaultAsyncResultPollInterval = time.Second
+
+// Invoke synchronously executes the function identified by functionID with the given Event, returning the
+// decoded result envelope. This matches the semantics of the frontier action type "e5e_function".
+func Invoke(ctx context.Context, a api.API, functionID string, event Event) (*Result, error) {
+	execution := functionExecution{FunctionIdentifier: functionID, Event: event}
+	if err := a.Create(ctx, &execution); err != nil {
+		return nil, fmt.Errorf("failed to invoke function: %w", err)
+	}
+
+	return &execution.Result, nil
+}
+
+// InvokeAsync starts an asynchronous execution of the function identified by functionID with the given Event.
+// This matches the semantics of the frontier action type "e5e_async_function".
+func InvokeAsync(ctx context.Context, a api.API, functionID string, event Event) (*AsyncExecution, error) {
+	execution := functionExecution{FunctionIdentifier: functionID, Event: event, Async: true}
+	if err := a.Create(ctx, &execution); err != nil {
+		return nil, fmt.Errorf("failed to invoke function asynchronously: %w", err)
+	}
+
+	return &AsyncExecution{
+		FunctionIdentifier:  functionID,
+		ExecutionIdentifier: execution.ExecutionIdentifier,
+	}, nil
+}
+
+// GetAsyncResult retrieves the result of the given asynchronous execution, returning ErrAsyncResultPending
+// while the function is still running. This matches the semantics of the frontier action type "e5e_async_result".
+func GetAsyncResult(ctx context.Context, a api.API, execution *AsyncExecution) (*Result, error) {
+	result := functionAsyncResult{
+		FunctionIdentifier:  execution.FunctionIdentifier,
+		ExecutionIdentifier: execution.ExecutionIdentifier,
+	}
+
+	if err := a.Get(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to get async function result: %w", err)
+	}
+
+	if result.Pending {
+		return nil, ErrAsyncResultPending
+	}
+
+	return &result.Result, nil
+}
+
+// AwaitAsyncResult polls the result of the given asynchronous execution in the given interval (one second
+// if zero) until it is available or the context is done.
+func AwaitAsyncResult(ctx context.Context, a api.API, execution *AsyncExecution, interval time.Duration) (*Result, error) {
+	if interval <= 0 {
+		interval = defaultAsyncResultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := GetAsyncResult(ctx, a, execution)
+		if err == nil {
+			return result, nil
+		} else if !errors.Is(err, ErrAsyncResultPending) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// EndpointURL returns the URL to execute the function. Only Create operations are supported.
+func (e *functionExecution) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationCreate {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	action := "execute"
+	if e.Async {
+		action = "execute_async"
+	}
+
+	return url.Parse("/api/e5e/v1/function.json/" + url.PathEscape(e.FunctionIdentifier) + "/" + action)
+}
+
+// FilterAPIRequestBody sends the Event as request body.
+func (e *functionExecution) FilterAPIRequestBody(ctx context.Context) (interface{}, error) {
+	return e.Event, nil
+}
+
+// DecodeAPIResponse decodes the result envelope for synchronous executions and the execution identifier
+// for asynchronous ones.
+func (e *functionExecution) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
+	if e.Async {
+		execution := AsyncExecution{}
+		if err := json.NewDecoder(data).Decode(&execution); err != nil {
+			return err
+		}
+
+		e.ExecutionIdentifier = execution.ExecutionIdentifier
+		return nil
+	}
+
+	return json.NewDecoder(data).Decode(&e.Result)
+}
+
+// EndpointURL returns the URL to retrieve async results of the function. Only Get operations are supported.
+func (r *functionAsyncResult) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationGet {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	return url.Parse("/api/e5e/v1/function.json/" + url.PathEscape(r.FunctionIdentifier) + "/async_result")
+}
+
+// FilterAPIResponse marks the result as pending when the Engine responds with 202 Accepted, which it does
+// while the function is still executing.
+func (r *functionAsyncResult) FilterAPIResponse(ctx context.Context, res *http.Response) (*http.Response, error) {
+	r.Pending = res.StatusCode == http.StatusAccepted
+
+	if r.Pending {
+		res.StatusCode = http.StatusNoContent
+		res.Body.Close()
+		res.Body = io.NopCloser(&bytes.Buffer{})
+	}
+
+	return res, nil
+}
+
+// DecodeAPIResponse decodes the result envelope.
+func (r *functionAsyncResult) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
+	return json.NewDecoder(data).Decode(&r.Result)
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("function invocation", func() {
+	var (
+		engine api.API
+		srv    *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		engine, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	event := Event{
+		Type:           DataTypeObject,
+		Data:           map[string]string{"name": "world"},
+		RequestHeaders: map[string]string{"X-Foo": "bar"},
+	}
+
+	It("invokes functions synchronously", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", "/api/e5e/v1/function.json/fn-id/execute"),
+			ghttp.VerifyJSON(`{"type":"object","data":{"name":"world"},"request_headers":{"X-Foo":"bar"}}`),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+				"status":           201,
+				"response_headers": map[string]string{"Content-Type": "application/json"},
+				"type":             "object",
+				"data":             map[string]string{"greeting": "hello world"},
+			}),
+		))
+
+		res, err := Invoke(context.TODO(), engine, "fn-id", event)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Status).To(Equal(201))
+		Expect(res.ResponseHeaders).To(HaveKeyWithValue("Content-Type", "application/json"))
+		Expect(res.Type).To(Equal(DataTypeObject))
+
+		data := map[string]string{}
+		Expect(res.DecodeData(&data)).To(Succeed())
+		Expect(data).To(HaveKeyWithValue("greeting", "hello world"))
+	})
+
+	It("invokes functions asynchronously and polls the result", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/e5e/v1/function.json/fn-id/execute_async"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "exec-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id/async_result/exec-id"),
+				ghttp.RespondWith(http.StatusAccepted, ""),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id/async_result/exec-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+					"status": 200,
+					"type":   "text",
+					"data":   "done",
+				}),
+			),
+		)
+
+		execution, err := InvokeAsync(context.TODO(), engine, "fn-id", event)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(execution.ExecutionIdentifier).To(Equal("exec-id"))
+
+		res, err := AwaitAsyncResult(context.TODO(), engine, execution, time.Millisecond)
+		Expect(err).NotTo(HaveOccurred())
+
+		var data string
+		Expect(res.DecodeData(&data)).To(Succeed())
+		Expect(data).To(Equal("done"))
+	})
+
+	It("reports pending async results", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/e5e/v1/function.json/fn-id/async_result/exec-id"),
+			ghttp.RespondWith(http.StatusAccepted, ""),
+		))
+
+		_, err := GetAsyncResult(context.TODO(), engine, &AsyncExecution{FunctionIdentifier: "fn-id", ExecutionIdentifier: "exec-id"})
+		Expect(err).To(MatchError(ErrAsyncResultPending))
+	})
+})
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrAsyncResultPending is returned when the result of an asynchronous function execution is not yet available.
+var ErrAsyncResultPending = errors.New("async function result is not yet available")
+
+// DataType describes how the data of an Event or Result is encoded.
+type DataType string
+
+const (
+	// DataTypeObject marks data as arbitrary JSON value.
+	DataTypeObject DataType = "object"
+	// DataTypeText marks data as plain text string.
+	DataTypeText DataType = "text"
+	// DataTypeBinary marks data as base64 encoded binary string.
+	DataTypeBinary DataType = "binary"
+)
+
+// Event is the payload passed to an e5e function when invoking it.
+type Event struct {
+	Type           DataType            `json:"type,omitempty"`
+	Data           interface{}         `json:"data,omitempty"`
+	Params         map[string][]string `json:"params,omitempty"`
+	RequestHeaders map[string]string   `json:"request_headers,omitempty"`
+	RequestMethod  string              `json:"request_method,omitempty"`
+	RequestPath    string              `json:"request_path,omitempty"`
+}
+
+// Result is the envelope returned by an e5e function.
+type Result struct {
+	Status          int               `json:"status,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	Type            DataType          `json:"type,omitempty"`
+	Data            json.RawMessage   `json:"data,omitempty"`
+}
+
+// DecodeData decodes the data of the Result into v.
+func (r *Result) DecodeData(v interface{}) error {
+	if len(r.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(r.Data, v)
+}
+
+// AsyncExecution references an asynchronous execution of an e5e function.
+type AsyncExecution struct {
+	FunctionIdentifier  string `json:"-"`
+	ExecutionIdentifier string `json:"identifier"`
+}
+
+// functionExecution is a virtual Object used to invoke an e5e function, synchronously or asynchronously.
+type functionExecution struct {
+	FunctionIdentifier  string `json:"-" anxcloud:"identifier"`
+	Async               bool   `json:"-"`
+	Event               Event  `json:"-"`
+	Result              Result `json:"-"`
+	ExecutionIdentifier string `json:"-"`
+}
+
+// functionAsyncResult is a virtual Object used to retrieve the result of an asynchronous e5e function execution.
+type functionAsyncResult struct {
+	FunctionIdentifier  string `json:"-"`
+	ExecutionIdentifier string `json:"-" anxcloud:"identifier"`
+	Pending             bool   `json:"-"`
+	Result              Result `json:"-"`
+}
@@ -8,6 +8,13 @@ const (
 	StorageBackendArchive = "archive"
 )
 
+// Deployment states reported in Function.DeploymentState. Any other state means the deployment is still in progress.
+const (
+	DeploymentStateSuccess = "success"
+	DeploymentStateFailed  = "failed"
+	DeploymentStateError   = "error"
+)
+
 type WorkerType string
 
 const (
@@ -38,6 +45,14 @@ type Function struct {
 	QuotaTimeout          int                    `json:"quota_timeout,omitempty"`
 	QuotaConcurrency      int                    `json:"quota_concurrency,omitempty"`
 	WorkerType            string                 `json:"worker_type,omitempty"`
+	DeploymentErrors      []DeploymentError      `json:"deployment_errors,omitempty"`
+}
+
+// DeploymentError describes a single problem reported by the e5e platform while building a Function.
+type DeploymentError struct {
+	Message string `json:"message"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
 }
 
 // StorageBackendMeta is used to configure a storage backend
@@ -51,9 +66,9 @@ type StorageBackendMeta struct {
 type StorageBackendMetaGit struct {
 	URL        string `json:"git_url,omitempty"`
 	Branch     string `json:"git_branch,omitempty"`
-	PrivateKey string `json:"git_private_key,omitempty"`
+	PrivateKey string `json:"git_private_key,omitempty" anxcloud:"secret"`
 	Username   string `json:"git_username,omitempty"`
-	Password   string `json:"git_password,omitempty"`
+	Password   string `json:"git_password,omitempty" anxcloud:"secret"`
 }
 
 // StorageBackendMetaS3 is used to configure a s3 storage backend
@@ -62,7 +77,7 @@ type StorageBackendMetaS3 struct {
 	BucketName string `json:"s3_bucket_name,omitempty"`
 	ObjectPath string `json:"s3_object_path,omitempty"`
 	AccessKey  string `json:"s3_access_key,omitempty"`
-	SecretKey  string `json:"s3_secret_key,omitempty"`
+	SecretKey  string `json:"s3_secret_key,omitempty" anxcloud:"secret"`
 }
 
 // StorageBackendMetaArchive is used to configure an archive storage backend
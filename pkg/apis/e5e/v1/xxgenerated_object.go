@@ -4,6 +4,7 @@ package v1
 
 import (
 	"context"
+	"slices"
 )
 
 // GetIdentifier returns the primary identifier of a Application object
@@ -11,7 +12,294 @@ func (o *Application) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
 
+// GetIdentifier returns the primary identifier of a functionExecution object
+func (o *functionExecution) GetIdentifier(ctx context.Context) (string, error) {
+	return o.FunctionIdentifier, nil
+}
+
+// GetIdentifier returns the primary identifier of a functionAsyncResult object
+func (o *functionAsyncResult) GetIdentifier(ctx context.Context) (string, error) {
+	return o.ExecutionIdentifier, nil
+}
+
 // GetIdentifier returns the primary identifier of a Function object
 func (o *Function) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the Application object. Values stored in interface fields are copied shallowly.
+func (o *Application) DeepCopy() *Application {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+
+	return &out
+}
+
+// Equal returns if the Application object is equal to the given one, comparing all fields deeply.
+func (o *Application) Equal(other *Application) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+
+	return true
+}
+
+// ApplicationFields contains the names of the fields of Application objects, usable with compare.Compare and compare.Reconcile.
+var ApplicationFields = struct {
+	Identifier string
+	Name       string
+}{
+	Identifier: "Identifier",
+	Name:       "Name",
+}
+
+// DeepCopy returns a deep copy of the Function object. Values stored in interface fields are copied shallowly.
+func (o *Function) DeepCopy() *Function {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.StorageBackendMeta != nil {
+		v1 := *out.StorageBackendMeta
+		if v1.StorageBackendMetaGit != nil {
+			v3 := *v1.StorageBackendMetaGit
+			v1.StorageBackendMetaGit = &v3
+		}
+		if v1.StorageBackendMetaS3 != nil {
+			v3 := *v1.StorageBackendMetaS3
+			v1.StorageBackendMetaS3 = &v3
+		}
+		if v1.StorageBackendMetaArchive != nil {
+			v3 := *v1.StorageBackendMetaArchive
+			v1.StorageBackendMetaArchive = &v3
+		}
+		out.StorageBackendMeta = &v1
+	}
+	if out.EnvironmentVariables != nil {
+		v1 := *out.EnvironmentVariables
+		v1 = slices.Clone(v1)
+		out.EnvironmentVariables = &v1
+	}
+	if out.Hostnames != nil {
+		v1 := *out.Hostnames
+		v1 = slices.Clone(v1)
+		out.Hostnames = &v1
+	}
+	out.DeploymentErrors = slices.Clone(out.DeploymentErrors)
+
+	return &out
+}
+
+// Equal returns if the Function object is equal to the given one, comparing all fields deeply.
+func (o *Function) Equal(other *Function) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.State != other.State {
+		return false
+	}
+	if o.DeploymentState != other.DeploymentState {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.ApplicationIdentifier != other.ApplicationIdentifier {
+		return false
+	}
+	if o.Runtime != other.Runtime {
+		return false
+	}
+	if o.Entrypoint != other.Entrypoint {
+		return false
+	}
+	if o.StorageBackend != other.StorageBackend {
+		return false
+	}
+	if (o.StorageBackendMeta == nil) != (other.StorageBackendMeta == nil) {
+		return false
+	}
+	if o.StorageBackendMeta != nil {
+		if ((*o.StorageBackendMeta).StorageBackendMetaGit == nil) != ((*other.StorageBackendMeta).StorageBackendMetaGit == nil) {
+			return false
+		}
+		if (*o.StorageBackendMeta).StorageBackendMetaGit != nil {
+			if (*(*o.StorageBackendMeta).StorageBackendMetaGit).URL != (*(*other.StorageBackendMeta).StorageBackendMetaGit).URL {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaGit).Branch != (*(*other.StorageBackendMeta).StorageBackendMetaGit).Branch {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaGit).PrivateKey != (*(*other.StorageBackendMeta).StorageBackendMetaGit).PrivateKey {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaGit).Username != (*(*other.StorageBackendMeta).StorageBackendMetaGit).Username {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaGit).Password != (*(*other.StorageBackendMeta).StorageBackendMetaGit).Password {
+				return false
+			}
+		}
+		if ((*o.StorageBackendMeta).StorageBackendMetaS3 == nil) != ((*other.StorageBackendMeta).StorageBackendMetaS3 == nil) {
+			return false
+		}
+		if (*o.StorageBackendMeta).StorageBackendMetaS3 != nil {
+			if (*(*o.StorageBackendMeta).StorageBackendMetaS3).Endpoint != (*(*other.StorageBackendMeta).StorageBackendMetaS3).Endpoint {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaS3).BucketName != (*(*other.StorageBackendMeta).StorageBackendMetaS3).BucketName {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaS3).ObjectPath != (*(*other.StorageBackendMeta).StorageBackendMetaS3).ObjectPath {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaS3).AccessKey != (*(*other.StorageBackendMeta).StorageBackendMetaS3).AccessKey {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaS3).SecretKey != (*(*other.StorageBackendMeta).StorageBackendMetaS3).SecretKey {
+				return false
+			}
+		}
+		if ((*o.StorageBackendMeta).StorageBackendMetaArchive == nil) != ((*other.StorageBackendMeta).StorageBackendMetaArchive == nil) {
+			return false
+		}
+		if (*o.StorageBackendMeta).StorageBackendMetaArchive != nil {
+			if (*(*o.StorageBackendMeta).StorageBackendMetaArchive).Content != (*(*other.StorageBackendMeta).StorageBackendMetaArchive).Content {
+				return false
+			}
+			if (*(*o.StorageBackendMeta).StorageBackendMetaArchive).Name != (*(*other.StorageBackendMeta).StorageBackendMetaArchive).Name {
+				return false
+			}
+		}
+	}
+	if (o.EnvironmentVariables == nil) != (other.EnvironmentVariables == nil) {
+		return false
+	}
+	if o.EnvironmentVariables != nil {
+		if ((*o.EnvironmentVariables) == nil) != ((*other.EnvironmentVariables) == nil) || len((*o.EnvironmentVariables)) != len((*other.EnvironmentVariables)) {
+			return false
+		}
+		for i2 := range *o.EnvironmentVariables {
+			if (*o.EnvironmentVariables)[i2].Name != (*other.EnvironmentVariables)[i2].Name {
+				return false
+			}
+			if (*o.EnvironmentVariables)[i2].Value != (*other.EnvironmentVariables)[i2].Value {
+				return false
+			}
+			if (*o.EnvironmentVariables)[i2].Secret != (*other.EnvironmentVariables)[i2].Secret {
+				return false
+			}
+		}
+	}
+	if (o.Hostnames == nil) != (other.Hostnames == nil) {
+		return false
+	}
+	if o.Hostnames != nil {
+		if ((*o.Hostnames) == nil) != ((*other.Hostnames) == nil) || len((*o.Hostnames)) != len((*other.Hostnames)) {
+			return false
+		}
+		for i2 := range *o.Hostnames {
+			if (*o.Hostnames)[i2].Hostname != (*other.Hostnames)[i2].Hostname {
+				return false
+			}
+			if (*o.Hostnames)[i2].IP != (*other.Hostnames)[i2].IP {
+				return false
+			}
+		}
+	}
+	if o.KeepAlive != other.KeepAlive {
+		return false
+	}
+	if o.QuotaStorage != other.QuotaStorage {
+		return false
+	}
+	if o.QuotaMemory != other.QuotaMemory {
+		return false
+	}
+	if o.QuotaCPU != other.QuotaCPU {
+		return false
+	}
+	if o.QuotaTimeout != other.QuotaTimeout {
+		return false
+	}
+	if o.QuotaConcurrency != other.QuotaConcurrency {
+		return false
+	}
+	if o.WorkerType != other.WorkerType {
+		return false
+	}
+	if (o.DeploymentErrors == nil) != (other.DeploymentErrors == nil) || len(o.DeploymentErrors) != len(other.DeploymentErrors) {
+		return false
+	}
+	for i1 := range o.DeploymentErrors {
+		if o.DeploymentErrors[i1].Message != other.DeploymentErrors[i1].Message {
+			return false
+		}
+		if o.DeploymentErrors[i1].File != other.DeploymentErrors[i1].File {
+			return false
+		}
+		if o.DeploymentErrors[i1].Line != other.DeploymentErrors[i1].Line {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FunctionFields contains the names of the fields of Function objects, usable with compare.Compare and compare.Reconcile.
+var FunctionFields = struct {
+	Identifier            string
+	State                 string
+	DeploymentState       string
+	Name                  string
+	ApplicationIdentifier string
+	Runtime               string
+	Entrypoint            string
+	StorageBackend        string
+	StorageBackendMeta    string
+	EnvironmentVariables  string
+	Hostnames             string
+	KeepAlive             string
+	QuotaStorage          string
+	QuotaMemory           string
+	QuotaCPU              string
+	QuotaTimeout          string
+	QuotaConcurrency      string
+	WorkerType            string
+	DeploymentErrors      string
+}{
+	Identifier:            "Identifier",
+	State:                 "State",
+	DeploymentState:       "DeploymentState",
+	Name:                  "Name",
+	ApplicationIdentifier: "ApplicationIdentifier",
+	Runtime:               "Runtime",
+	Entrypoint:            "Entrypoint",
+	StorageBackend:        "StorageBackend",
+	StorageBackendMeta:    "StorageBackendMeta",
+	EnvironmentVariables:  "EnvironmentVariables",
+	Hostnames:             "Hostnames",
+	KeepAlive:             "KeepAlive",
+	QuotaStorage:          "QuotaStorage",
+	QuotaMemory:           "QuotaMemory",
+	QuotaCPU:              "QuotaCPU",
+	QuotaTimeout:          "QuotaTimeout",
+	QuotaConcurrency:      "QuotaConcurrency",
+	WorkerType:            "WorkerType",
+	DeploymentErrors:      "DeploymentErrors",
+}
@@ -20,6 +20,9 @@ var _ = Describe("Object Application", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ApplicationFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Function", func() {
@@ -32,4 +35,7 @@ var _ = Describe("Object Function", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.FunctionFields)
+	testutils.ContractTests(&o)
 })
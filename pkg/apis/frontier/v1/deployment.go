@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+)
+
+// deploymentPollInterval is the interval in which a Deployment is retrieved while waiting for it to be enabled.
+const deploymentPollInterval = 5 * time.Second
+
+var (
+	// ErrDeploymentFailed is returned when a Deployment could not be rolled out.
+	ErrDeploymentFailed = errors.New("frontier deployment failed")
+
+	// ErrNoEnabledDeployment is returned when a slug has no enabled Deployment to promote.
+	ErrNoEnabledDeployment = errors.New("no enabled deployment for slug")
+
+	// ErrStaleDeployment is returned when promoting a slug whose enabled Deployment is not the latest
+	// Deployment of the API, as promoting always deploys the current configuration of the API.
+	ErrStaleDeployment = errors.New("enabled deployment of slug is not the latest deployment of the api")
+
+	// ErrDeploymentAPIMismatch is returned when rolling back to a Deployment of another API or slug.
+	ErrDeploymentAPIMismatch = errors.New("deployment does not belong to the given api and slug")
+)
+
+// Deploy deploys the current configuration of the API identified by apiID under the given slug and waits
+// until the new Deployment is enabled.
+func Deploy(ctx context.Context, a api.API, apiID, slug string) (*Deployment, error) {
+	deployment := Deployment{APIIdentifier: apiID, Slug: slug}
+	if err := a.Create(ctx, &deployment); err != nil {
+		return nil, fmt.Errorf("failed to deploy api: %w", err)
+	}
+
+	if err := AwaitDeployment(ctx, a, &deployment); err != nil {
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+// AwaitDeployment blocks until the given Deployment is enabled, returning ErrDeploymentFailed if it failed
+// or was disabled while waiting.
+func AwaitDeployment(ctx context.Context, a api.API, deployment *Deployment) error {
+	ticker := time.NewTicker(deploymentPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Get(ctx, deployment); err != nil {
+			return fmt.Errorf("failed to get deployment: %w", err)
+		}
+
+		if deployment.State.IsEnabled() {
+			return nil
+		} else if !deployment.State.IsPending() {
+			return fmt.Errorf("%w: deployment %q in state %q", ErrDeploymentFailed, deployment.Identifier, deployment.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
+
+// ListDeployments returns the deployment history of the API identified by apiID in the order returned by
+// the Engine. If slug is not empty, only Deployments of that slug are returned.
+func ListDeployments(ctx context.Context, a api.API, apiID, slug string) ([]Deployment, error) {
+	all, err := listObjects[Deployment](ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	deployments := make([]Deployment, 0)
+	for _, d := range all {
+		if d.APIIdentifier == apiID && (slug == "" || d.Slug == slug) {
+			deployments = append(deployments, d)
+		}
+	}
+
+	return deployments, nil
+}
+
+// EnabledDeployment returns the Deployment currently serving the given slug of the API identified by apiID,
+// or ErrNoEnabledDeployment.
+func EnabledDeployment(ctx context.Context, a api.API, apiID, slug string) (*Deployment, error) {
+	deployments, err := ListDeployments(ctx, a, apiID, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range deployments {
+		if deployments[i].State.IsEnabled() {
+			return &deployments[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrNoEnabledDeployment, slug)
+}
+
+// Rollback enables the previous Deployment identified by deploymentID for its slug again and disables the
+// Deployment currently serving the slug, if any. If enabling the previous Deployment fails, the Deployment
+// serving the slug before is enabled again.
+func Rollback(ctx context.Context, a api.API, apiID, slug, deploymentID string) (*Deployment, error) {
+	target := Deployment{Identifier: deploymentID}
+	if err := a.Get(ctx, &target); err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	if target.APIIdentifier != apiID || target.Slug != slug {
+		return nil, fmt.Errorf("%w: deployment %q", ErrDeploymentAPIMismatch, deploymentID)
+	}
+
+	current, err := EnabledDeployment(ctx, a, apiID, slug)
+	if err != nil && !errors.Is(err, ErrNoEnabledDeployment) {
+		return nil, err
+	} else if err == nil && current.Identifier == target.Identifier {
+		return &target, nil
+	}
+
+	if current != nil {
+		current.State = DeploymentStateDisabled
+		if err := a.Update(ctx, current); err != nil {
+			return nil, fmt.Errorf("failed to disable deployment %q: %w", current.Identifier, err)
+		}
+	}
+
+	target.State = DeploymentStateEnabled
+	if err := a.Update(ctx, &target); err != nil {
+		return nil, restoreDeployment(ctx, a, current, fmt.Errorf("failed to enable deployment %q: %w", target.Identifier, err))
+	}
+
+	if err := AwaitDeployment(ctx, a, &target); err != nil {
+		return nil, restoreDeployment(ctx, a, current, err)
+	}
+
+	return &target, nil
+}
+
+// restoreDeployment enables the given Deployment, if any, again after Rollback failed with err.
+func restoreDeployment(ctx context.Context, a api.API, deployment *Deployment, err error) error {
+	if deployment == nil {
+		return err
+	}
+
+	deployment.State = DeploymentStateEnabled
+	if restoreErr := a.Update(ctx, deployment); restoreErr != nil {
+		return fmt.Errorf("%w, failed to enable deployment %q again: %w", err, deployment.Identifier, restoreErr)
+	}
+
+	return err
+}
+
+// Promote deploys the configuration serving slug from under slug to of the same API, e.g. from "staging" to
+// "prod". As Deployments can only be created from the current configuration of the API, ErrStaleDeployment
+// is returned if the Deployment enabled for slug from is not the latest Deployment of the API.
+func Promote(ctx context.Context, a api.API, apiID, from, to string) (*Deployment, error) {
+	source, err := EnabledDeployment(ctx, a, apiID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	frontierAPI := API{Identifier: apiID}
+	if err := a.Get(ctx, &frontierAPI); err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	if frontierAPI.DeploymentIdentifier != source.Identifier {
+		return nil, fmt.Errorf("%w: slug %q serves deployment %q, latest is %q",
+			ErrStaleDeployment, from, source.Identifier, frontierAPI.DeploymentIdentifier)
+	}
+
+	return Deploy(ctx, a, apiID, to)
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+var _ = Describe("Deployment helpers", func() {
+	var (
+		engine api.API
+		srv    *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		engine, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	history := []map[string]any{
+		{"identifier": "dep-1", "api_identifier": "api-id", "slug": "prod", "state": "disabled"},
+		{"identifier": "dep-2", "api_identifier": "api-id", "slug": "prod", "state": "enabled"},
+		{"identifier": "dep-3", "api_identifier": "api-id", "slug": "staging", "state": "enabled"},
+		{"identifier": "dep-4", "api_identifier": "other-api", "slug": "prod", "state": "enabled"},
+	}
+
+	It("deploys and waits for the deployment to be enabled", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/frontier/v1/api.json/api-id/deploy"),
+				ghttp.VerifyJSON(`{"slug":"prod"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "api-id", "deployment_identifier": "dep-5"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/frontier/v1/deployment.json/dep-5"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-5", "slug": "prod", "state": "enabled"}),
+			),
+		)
+
+		d, err := Deploy(context.TODO(), engine, "api-id", "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Identifier).To(Equal("dep-5"))
+		Expect(d.State.IsEnabled()).To(BeTrue())
+	})
+
+	It("returns an error when the deployment failed", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/frontier/v1/deployment.json/dep-5"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-5", "state": "failed"}),
+		))
+
+		err := AwaitDeployment(context.TODO(), engine, &Deployment{Identifier: "dep-5"})
+		Expect(err).To(MatchError(ErrDeploymentFailed))
+	})
+
+	It("lists the deployment history of a slug", func() {
+		appendListHandlers(srv, "/api/frontier/v1/deployment.json", history)
+
+		deployments, err := ListDeployments(context.TODO(), engine, "api-id", "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deployments).To(HaveLen(2))
+		Expect(deployments[0].Identifier).To(Equal("dep-1"))
+		Expect(deployments[1].Identifier).To(Equal("dep-2"))
+	})
+
+	It("rolls back a slug to a previous deployment", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/frontier/v1/deployment.json/dep-1"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, history[0]),
+		))
+		appendListHandlers(srv, "/api/frontier/v1/deployment.json", history)
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/deployment.json/dep-2"),
+				ghttp.VerifyJSON(`{"identifier":"dep-2","api_identifier":"api-id","slug":"prod","state":"disabled"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-2"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/deployment.json/dep-1"),
+				ghttp.VerifyJSON(`{"identifier":"dep-1","api_identifier":"api-id","slug":"prod","state":"enabled"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-1"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/frontier/v1/deployment.json/dep-1"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-1", "api_identifier": "api-id", "slug": "prod", "state": "enabled"}),
+			),
+		)
+
+		d, err := Rollback(context.TODO(), engine, "api-id", "prod", "dep-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.State).To(Equal(DeploymentStateEnabled))
+	})
+
+	It("enables the current deployment again when the rollback failed", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/frontier/v1/deployment.json/dep-1"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, history[0]),
+		))
+		appendListHandlers(srv, "/api/frontier/v1/deployment.json", history)
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/deployment.json/dep-2"),
+				ghttp.VerifyJSON(`{"identifier":"dep-2","api_identifier":"api-id","slug":"prod","state":"disabled"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-2"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/deployment.json/dep-1"),
+				ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, map[string]any{"error": "internal error"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/deployment.json/dep-2"),
+				ghttp.VerifyJSON(`{"identifier":"dep-2","api_identifier":"api-id","slug":"prod","state":"enabled"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "dep-2"}),
+			),
+		)
+
+		_, err := Rollback(context.TODO(), engine, "api-id", "prod", "dep-1")
+		Expect(err).To(MatchError(ContainSubstring(`failed to enable deployment "dep-1"`)))
+		Expect(srv.ReceivedRequests()).To(HaveLen(6))
+	})
+
+	It("refuses to promote a stale deployment", func() {
+		appendListHandlers(srv, "/api/frontier/v1/deployment.json", history)
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/frontier/v1/api.json/api-id"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "api-id", "deployment_identifier": "dep-7"}),
+		))
+
+		_, err := Promote(context.TODO(), engine, "api-id", "staging", "prod")
+		Expect(err).To(MatchError(ErrStaleDeployment))
+	})
+})
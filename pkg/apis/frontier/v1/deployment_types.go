@@ -1,6 +1,37 @@
 package v1
 
-// anxcloud:object
+import "strings"
+
+// DeploymentState is the state of a Deployment.
+type DeploymentState string
+
+const (
+	// DeploymentStatePending marks a Deployment still being rolled out.
+	DeploymentStatePending DeploymentState = "pending"
+	// DeploymentStateEnabled marks the Deployment currently serving its slug.
+	DeploymentStateEnabled DeploymentState = "enabled"
+	// DeploymentStateDisabled marks a Deployment not serving its slug (anymore).
+	DeploymentStateDisabled DeploymentState = "disabled"
+	// DeploymentStateFailed marks a Deployment which could not be rolled out.
+	DeploymentStateFailed DeploymentState = "failed"
+)
+
+// IsEnabled returns true if the Deployment is serving its slug.
+func (s DeploymentState) IsEnabled() bool {
+	return strings.EqualFold(string(s), string(DeploymentStateEnabled))
+}
+
+// IsFailed returns true if the Deployment could not be rolled out.
+func (s DeploymentState) IsFailed() bool {
+	return strings.EqualFold(string(s), string(DeploymentStateFailed)) || strings.EqualFold(string(s), "error")
+}
+
+// IsPending returns true if the Deployment is still being rolled out.
+func (s DeploymentState) IsPending() bool {
+	return !s.IsEnabled() && !s.IsFailed() && !strings.EqualFold(string(s), string(DeploymentStateDisabled))
+}
+
+// anxcloud:object:nocontract
 
 // Deployment represents a published version of a Frontier API with all its endpoints
 // and actions exactly as it was at the time it was deployed.
@@ -8,9 +39,9 @@ package v1
 // Use api.Get on the same struct instance to retrieve all data.
 type Deployment struct {
 	omitResponseDecodeOnDestroy
-	Identifier    string `json:"identifier,omitempty" anxcloud:"identifier"`
-	APIIdentifier string `json:"api_identifier,omitempty"`
-	Name          string `json:"name,omitempty"`
-	Slug          string `json:"slug,omitempty"`
-	State         string `json:"state,omitempty"`
+	Identifier    string          `json:"identifier,omitempty" anxcloud:"identifier"`
+	APIIdentifier string          `json:"api_identifier,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Slug          string          `json:"slug,omitempty"`
+	State         DeploymentState `json:"state,omitempty"`
 }
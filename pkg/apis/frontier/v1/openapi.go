@@ -0,0 +1,351 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+var (
+	// ErrUnsupportedOpenAPIVersion is returned when parsing a document that is not an OpenAPI 3 document.
+	ErrUnsupportedOpenAPIVersion = errors.New("only OpenAPI 3 documents are supported")
+
+	// ErrInvalidFrontierAction is returned when an x-frontier-action extension is invalid.
+	ErrInvalidFrontierAction = errors.New("invalid x-frontier-action extension")
+)
+
+// ChangeType describes what is done to an Object when reconciling a Frontier API with an OpenAPI document.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate marks an Object to be created.
+	ChangeTypeCreate ChangeType = "create"
+	// ChangeTypeUpdate marks an Object to be updated.
+	ChangeTypeUpdate ChangeType = "update"
+	// ChangeTypeDelete marks an Object to be deleted.
+	ChangeTypeDelete ChangeType = "delete"
+)
+
+// Change is a single change needed to make a Frontier API match an OpenAPI document.
+type Change struct {
+	Type ChangeType
+
+	// Object is the *API, *Endpoint or *Action to create, update or delete.
+	Object types.Object
+
+	// Path is the path of the affected endpoint, empty for changes to the API itself.
+	Path string
+
+	// Method is the lower-case HTTP method of the affected action, empty for changes to the API or an endpoint.
+	Method string
+
+	// endpoint is the Endpoint a created Action belongs to, used to fill in the identifier of new endpoints.
+	endpoint *Endpoint
+}
+
+// String returns a human readable description of the Change, like "create action get /pets".
+func (c Change) String() string {
+	switch c.Object.(type) {
+	case *API:
+		return fmt.Sprintf("%s api", c.Type)
+	case *Endpoint:
+		return fmt.Sprintf("%s endpoint %s", c.Type, c.Path)
+	default:
+		return fmt.Sprintf("%s action %s %s", c.Type, c.Method, c.Path)
+	}
+}
+
+// ParseOpenAPI decodes an OpenAPI 3 document in JSON format and validates its x-frontier-action extensions.
+func ParseOpenAPI(r io.Reader) (*OpenAPIDocument, error) {
+	doc := OpenAPIDocument{}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode OpenAPI document: %w", err)
+	}
+
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%w, got version %q", ErrUnsupportedOpenAPIVersion, doc.OpenAPI)
+	}
+
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			if op == nil || op.Action == nil {
+				continue
+			}
+
+			if err := op.Action.validate(); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+		}
+	}
+
+	return &doc, nil
+}
+
+func (ext *OpenAPIFrontierAction) validate() error {
+	switch ext.Type {
+	case ActionTypeURLRewrite:
+		if ext.URL == "" {
+			return fmt.Errorf("%w: url required for type %q", ErrInvalidFrontierAction, ext.Type)
+		}
+	case ActionTypeMockResponse:
+	case ActionTypeE5EFunction, ActionTypeE5EAsyncFunction, ActionTypeE5EAsyncResult:
+		if ext.Function == "" {
+			return fmt.Errorf("%w: function required for type %q", ErrInvalidFrontierAction, ext.Type)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported type %q", ErrInvalidFrontierAction, ext.Type)
+	}
+
+	return nil
+}
+
+// DiffOpenAPI compares the Frontier API identified by apiID with the given OpenAPI document and returns the
+// changes needed to make the API match it, without applying them. Operations without x-frontier-action
+// extension don't get an action, endpoints and actions not contained in the document are deleted.
+func DiffOpenAPI(ctx context.Context, a api.API, apiID string, doc *OpenAPIDocument) ([]Change, error) {
+	state, err := loadFrontierState(ctx, a, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.diff(doc), nil
+}
+
+// ImportOpenAPI reconciles the Frontier API identified by apiID to match the given OpenAPI document, applying
+// the changes returned by DiffOpenAPI in order. The applied changes are returned, including the ones applied
+// before an error occurred.
+func ImportOpenAPI(ctx context.Context, a api.API, apiID string, doc *OpenAPIDocument) ([]Change, error) {
+	changes, err := DiffOpenAPI(ctx, a, apiID, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range changes {
+		if action, ok := c.Object.(*Action); ok && c.endpoint != nil {
+			action.EndpointIdentifier = c.endpoint.Identifier
+		}
+
+		switch c.Type {
+		case ChangeTypeCreate:
+			err = a.Create(ctx, c.Object)
+		case ChangeTypeUpdate:
+			err = a.Update(ctx, c.Object)
+		case ChangeTypeDelete:
+			err = api.IgnoreNotFound(a.Destroy(ctx, c.Object))
+		}
+
+		if err != nil {
+			return changes[:i], fmt.Errorf("failed to %v: %w", c, err)
+		}
+	}
+
+	return changes, nil
+}
+
+// ExportOpenAPI renders the Frontier API identified by apiID with all its endpoints and actions as OpenAPI 3
+// document, describing every action with the x-frontier-action extension.
+func ExportOpenAPI(ctx context.Context, a api.API, apiID string) (*OpenAPIDocument, error) {
+	state, err := loadFrontierState(ctx, a, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := OpenAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: OpenAPIInfo{
+			Title:   state.api.Name,
+			Version: "1.0.0",
+		},
+		Paths: make(map[string]OpenAPIPathItem, len(state.endpoints)),
+	}
+
+	if state.api.Description != nil {
+		doc.Info.Description = *state.api.Description
+	}
+
+	for path, endpoint := range state.endpoints {
+		item := make(OpenAPIPathItem)
+
+		for method, action := range state.actions[endpoint.Identifier] {
+			item[method] = &OpenAPIOperation{
+				Action: openAPIFrontierAction(action),
+				Responses: map[string]OpenAPIResponse{
+					"default": {Description: fmt.Sprintf("Response of the %s action", action.Type)},
+				},
+			}
+		}
+
+		doc.Paths[path] = item
+	}
+
+	return &doc, nil
+}
+
+// frontierState is the current state of a Frontier API, with endpoints by path and actions by
+// endpoint identifier and lower-case HTTP method.
+type frontierState struct {
+	api       *API
+	endpoints map[string]*Endpoint
+	actions   map[string]map[string]*Action
+}
+
+func loadFrontierState(ctx context.Context, a api.API, apiID string) (*frontierState, error) {
+	state := frontierState{
+		api:       &API{Identifier: apiID},
+		endpoints: make(map[string]*Endpoint),
+		actions:   make(map[string]map[string]*Action),
+	}
+
+	if err := a.Get(ctx, state.api); err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+
+	endpoints, err := listObjects[Endpoint](ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
+	}
+
+	for i := range endpoints {
+		if endpoints[i].APIIdentifier == apiID {
+			state.endpoints[endpoints[i].Path] = &endpoints[i]
+			state.actions[endpoints[i].Identifier] = make(map[string]*Action)
+		}
+	}
+
+	actions, err := listObjects[Action](ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions: %w", err)
+	}
+
+	for i := range actions {
+		if byMethod, ok := state.actions[actions[i].EndpointIdentifier]; ok {
+			byMethod[strings.ToLower(actions[i].HTTPRequestMethod)] = &actions[i]
+		}
+	}
+
+	return &state, nil
+}
+
+func (s *frontierState) diff(doc *OpenAPIDocument) []Change {
+	var creates, updates, deletes []Change
+
+	description := ""
+	if s.api.Description != nil {
+		description = *s.api.Description
+	}
+
+	if s.api.Name != doc.Info.Title || description != doc.Info.Description {
+		updated := *s.api
+		updated.Name = doc.Info.Title
+		updated.Description = &doc.Info.Description
+		updates = append(updates, Change{Type: ChangeTypeUpdate, Object: &updated})
+	}
+
+	for _, path := range sortedKeys(doc.Paths) {
+		item := doc.Paths[path]
+
+		endpoint, exists := s.endpoints[path]
+		if !exists {
+			endpoint = &Endpoint{Name: path, Path: path, APIIdentifier: s.api.Identifier}
+			creates = append(creates, Change{Type: ChangeTypeCreate, Object: endpoint, Path: path})
+		}
+
+		existing := s.actions[endpoint.Identifier]
+
+		for _, method := range sortedKeys(item) {
+			op := item[method]
+			if op == nil || op.Action == nil {
+				continue
+			}
+
+			current, ok := existing[method]
+			if !ok {
+				action := &Action{HTTPRequestMethod: method, Type: op.Action.Type, Meta: op.Action.actionMeta()}
+				change := Change{Type: ChangeTypeCreate, Object: action, Path: path, Method: method}
+				if exists {
+					action.EndpointIdentifier = endpoint.Identifier
+				} else {
+					change.endpoint = endpoint
+				}
+
+				creates = append(creates, change)
+			} else if *openAPIFrontierAction(current) != *op.Action {
+				updated := *current
+				updated.Type = op.Action.Type
+				updated.Meta = op.Action.actionMeta()
+				updates = append(updates, Change{Type: ChangeTypeUpdate, Object: &updated, Path: path, Method: method})
+			}
+		}
+
+		for _, method := range sortedKeys(existing) {
+			if op, ok := item[method]; !ok || op == nil || op.Action == nil {
+				deletes = append(deletes, Change{Type: ChangeTypeDelete, Object: existing[method], Path: path, Method: method})
+			}
+		}
+	}
+
+	var endpointDeletes []Change
+	for _, path := range sortedKeys(s.endpoints) {
+		if _, ok := doc.Paths[path]; ok {
+			continue
+		}
+
+		endpoint := s.endpoints[path]
+		existing := s.actions[endpoint.Identifier]
+		for _, method := range sortedKeys(existing) {
+			deletes = append(deletes, Change{Type: ChangeTypeDelete, Object: existing[method], Path: path, Method: method})
+		}
+
+		endpointDeletes = append(endpointDeletes, Change{Type: ChangeTypeDelete, Object: endpoint, Path: path})
+	}
+
+	changes := make([]Change, 0, len(creates)+len(updates)+len(deletes)+len(endpointDeletes))
+	changes = append(changes, updates...)
+	changes = append(changes, creates...)
+	changes = append(changes, deletes...)
+	changes = append(changes, endpointDeletes...)
+
+	return changes
+}
+
+func listObjects[T any, PT interface {
+	*T
+	types.Object
+}](ctx context.Context, a api.API) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var channel types.ObjectChannel
+	if err := a.List(ctx, PT(new(T)), api.ObjectChannel(&channel)); err != nil {
+		return nil, err
+	}
+
+	objects := make([]T, 0)
+	for retriever := range channel {
+		var o T
+		if err := retriever(PT(&o)); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, o)
+	}
+
+	return objects, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+const petstoreDocument = `{
+	"openapi": "3.0.3",
+	"info": {"title": "petstore", "version": "1.0.0"},
+	"paths": {
+		"/pets": {
+			"summary": "all the pets",
+			"get": {"x-frontier-action": {"type": "e5e_function", "function": "list-pets"}},
+			"post": {"x-frontier-action": {"type": "e5e_async_function", "function": "create-pet"}}
+		},
+		"/health": {
+			"get": {"x-frontier-action": {"type": "mock_response", "body": "ok", "language": "plaintext"}}
+		}
+	}
+}`
+
+// appendListHandlers responds to list requests for path with a single page containing items.
+func appendListHandlers(srv *ghttp.Server, path string, items []map[string]any) {
+	for page, data := range [][]map[string]any{items, {}} {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{
+				"page":        page + 1,
+				"total_items": len(items),
+				"limit":       10,
+				"data":        data,
+			}),
+		))
+	}
+}
+
+var _ = Describe("OpenAPI", func() {
+	var (
+		engine api.API
+		srv    *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		engine, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	appendStateHandlers := func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/frontier/v1/api.json/api-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "api-id", "name": "petstore"}),
+			),
+		)
+		appendListHandlers(srv, "/api/frontier/v1/endpoint.json", []map[string]any{
+			{"identifier": "ep-pets", "path": "/pets", "name": "/pets", "api_identifier": "api-id"},
+			{"identifier": "ep-old", "path": "/old", "name": "/old", "api_identifier": "api-id"},
+			{"identifier": "ep-other", "path": "/pets", "name": "/pets", "api_identifier": "other-api"},
+		})
+		appendListHandlers(srv, "/api/frontier/v1/action.json", []map[string]any{
+			{"identifier": "act-get", "endpoint_identifier": "ep-pets", "http_request_method": "get", "type": "e5e_function", "meta": map[string]any{"e5e_function_function": "list-pets"}},
+			{"identifier": "act-post", "endpoint_identifier": "ep-pets", "http_request_method": "post", "type": "e5e_function", "meta": map[string]any{"e5e_function_function": "create-pet"}},
+			{"identifier": "act-old", "endpoint_identifier": "ep-old", "http_request_method": "get", "type": "url_rewrite", "meta": map[string]any{"url_rewrite_url": "https://example.com"}},
+			{"identifier": "act-other", "endpoint_identifier": "ep-other", "http_request_method": "get", "type": "mock_response"},
+		})
+	}
+
+	It("rejects invalid documents", func() {
+		_, err := ParseOpenAPI(strings.NewReader(`{"openapi": "2.0"}`))
+		Expect(err).To(MatchError(ErrUnsupportedOpenAPIVersion))
+
+		_, err = ParseOpenAPI(strings.NewReader(`{"openapi": "3.1.0", "paths": {"/": {"get": {"x-frontier-action": {"type": "e5e_function"}}}}}`))
+		Expect(err).To(MatchError(ErrInvalidFrontierAction))
+	})
+
+	It("computes the changes needed to match the document", func() {
+		doc, err := ParseOpenAPI(strings.NewReader(petstoreDocument))
+		Expect(err).NotTo(HaveOccurred())
+
+		appendStateHandlers()
+
+		changes, err := DiffOpenAPI(context.TODO(), engine, "api-id", doc)
+		Expect(err).NotTo(HaveOccurred())
+
+		descriptions := make([]string, 0, len(changes))
+		for _, c := range changes {
+			descriptions = append(descriptions, c.String())
+		}
+
+		Expect(descriptions).To(Equal([]string{
+			"update action post /pets",
+			"create endpoint /health",
+			"create action get /health",
+			"delete action get /old",
+			"delete endpoint /old",
+		}))
+	})
+
+	It("applies the changes in order", func() {
+		doc, err := ParseOpenAPI(strings.NewReader(petstoreDocument))
+		Expect(err).NotTo(HaveOccurred())
+
+		appendStateHandlers()
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/frontier/v1/action.json/act-post"),
+				ghttp.VerifyJSON(`{"identifier":"act-post","endpoint_identifier":"ep-pets","http_request_method":"post","type":"e5e_async_function","meta":{"e5e_async_function_function":"create-pet"}}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "act-post"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/frontier/v1/endpoint.json"),
+				ghttp.VerifyJSON(`{"name":"/health","path":"/health","api_identifier":"api-id"}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "ep-health"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/frontier/v1/action.json"),
+				ghttp.VerifyJSON(`{"endpoint_identifier":"ep-health","http_request_method":"get","type":"mock_response","meta":{"mock_response_body":"ok","mock_response_language":"plaintext"}}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{"identifier": "act-health"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("DELETE", "/api/frontier/v1/action.json/act-old"),
+				ghttp.RespondWith(http.StatusOK, ""),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("DELETE", "/api/frontier/v1/endpoint.json/ep-old"),
+				ghttp.RespondWith(http.StatusOK, ""),
+			),
+		)
+
+		changes, err := ImportOpenAPI(context.TODO(), engine, "api-id", doc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(5))
+	})
+
+	It("exports the api as OpenAPI document", func() {
+		appendStateHandlers()
+
+		doc, err := ExportOpenAPI(context.TODO(), engine, "api-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Info.Title).To(Equal("petstore"))
+		Expect(doc.Paths).To(HaveLen(2))
+		Expect(doc.Paths["/pets"]).To(HaveLen(2))
+		Expect(doc.Paths["/pets"]["get"].Action).To(Equal(&OpenAPIFrontierAction{Type: ActionTypeE5EFunction, Function: "list-pets"}))
+		Expect(doc.Paths["/old"]["get"].Action).To(Equal(&OpenAPIFrontierAction{Type: ActionTypeURLRewrite, URL: "https://example.com"}))
+	})
+})
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// OpenAPIActionExtension is the name of the OpenAPI operation extension configuring the Frontier Action.
+const OpenAPIActionExtension = "x-frontier-action"
+
+// openAPIMethods are the operation keys of an OpenAPI path item, in the order they are rendered.
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// OpenAPIDocument is the subset of an OpenAPI 3 document relevant for Frontier APIs.
+type OpenAPIDocument struct {
+	OpenAPI string                     `json:"openapi"`
+	Info    OpenAPIInfo                `json:"info"`
+	Paths   map[string]OpenAPIPathItem `json:"paths"`
+}
+
+// OpenAPIInfo contains the metadata of an OpenAPI document, mapped to the Name and Description of the Frontier API.
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIPathItem maps lower-case HTTP methods to the operations of a single path. Other path item fields
+// are ignored when decoding.
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+// OpenAPIOperation is a single operation of an OpenAPI document, mapped to a Frontier Action.
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId,omitempty"`
+	Summary     string                     `json:"summary,omitempty"`
+	Action      *OpenAPIFrontierAction     `json:"x-frontier-action,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses,omitempty"`
+}
+
+// OpenAPIResponse is a single response of an OpenAPI operation, only rendered to have valid documents on export.
+type OpenAPIResponse struct {
+	Description string `json:"description"`
+}
+
+// OpenAPIFrontierAction is the value of the x-frontier-action extension, configuring the Frontier Action
+// handling an operation. Which fields are used depends on the Type.
+type OpenAPIFrontierAction struct {
+	Type ActionType `json:"type"`
+
+	// URL is the target of "url_rewrite" actions.
+	URL string `json:"url,omitempty"`
+
+	// Body and Language configure "mock_response" actions.
+	Body     string `json:"body,omitempty"`
+	Language string `json:"language,omitempty"`
+
+	// Function is the identifier of the e5e function of "e5e_function", "e5e_async_function"
+	// and "e5e_async_result" actions.
+	Function string `json:"function,omitempty"`
+}
+
+// UnmarshalJSON decodes only the operations of a path item, skipping fields like "parameters" or "summary".
+func (p *OpenAPIPathItem) UnmarshalJSON(data []byte) error {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	item := make(OpenAPIPathItem, len(raw))
+	for key, value := range raw {
+		method := strings.ToLower(key)
+		if !isOpenAPIMethod(method) {
+			continue
+		}
+
+		op := OpenAPIOperation{}
+		if err := json.Unmarshal(value, &op); err != nil {
+			return err
+		}
+
+		item[method] = &op
+	}
+
+	*p = item
+	return nil
+}
+
+func isOpenAPIMethod(method string) bool {
+	for _, m := range openAPIMethods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// actionMeta converts the extension into the ActionMeta of a Frontier Action.
+func (ext *OpenAPIFrontierAction) actionMeta() *ActionMeta {
+	switch ext.Type {
+	case ActionTypeURLRewrite:
+		return &ActionMeta{ActionMetaURLRewrite: &ActionMetaURLRewrite{URL: ext.URL}}
+	case ActionTypeMockResponse:
+		return &ActionMeta{ActionMetaMockResponse: &ActionMetaMockResponse{Body: ext.Body, Language: ext.Language}}
+	case ActionTypeE5EFunction:
+		return &ActionMeta{ActionMetaE5EFunction: &ActionMetaE5EFunction{FunctionIdentifier: ext.Function}}
+	case ActionTypeE5EAsyncFunction:
+		return &ActionMeta{ActionMetaE5EAsyncFunction: &ActionMetaE5EAsyncFunction{FunctionIdentifier: ext.Function}}
+	case ActionTypeE5EAsyncResult:
+		return &ActionMeta{ActionMetaE5EAsyncResult: &ActionMetaE5EAsyncResult{FunctionIdentifier: ext.Function}}
+	}
+
+	return nil
+}
+
+// openAPIFrontierAction converts a Frontier Action into the x-frontier-action extension.
+func openAPIFrontierAction(a *Action) *OpenAPIFrontierAction {
+	ext := OpenAPIFrontierAction{Type: a.Type}
+	if a.Meta == nil {
+		return &ext
+	}
+
+	switch a.Type {
+	case ActionTypeURLRewrite:
+		if a.Meta.ActionMetaURLRewrite != nil {
+			ext.URL = a.Meta.ActionMetaURLRewrite.URL
+		}
+	case ActionTypeMockResponse:
+		if a.Meta.ActionMetaMockResponse != nil {
+			ext.Body = a.Meta.ActionMetaMockResponse.Body
+			ext.Language = a.Meta.ActionMetaMockResponse.Language
+		}
+	case ActionTypeE5EFunction:
+		if a.Meta.ActionMetaE5EFunction != nil {
+			ext.Function = a.Meta.ActionMetaE5EFunction.FunctionIdentifier
+		}
+	case ActionTypeE5EAsyncFunction:
+		if a.Meta.ActionMetaE5EAsyncFunction != nil {
+			ext.Function = a.Meta.ActionMetaE5EAsyncFunction.FunctionIdentifier
+		}
+	case ActionTypeE5EAsyncResult:
+		if a.Meta.ActionMetaE5EAsyncResult != nil {
+			ext.Function = a.Meta.ActionMetaE5EAsyncResult.FunctionIdentifier
+		}
+	}
+
+	return &ext
+}
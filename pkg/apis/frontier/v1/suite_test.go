@@ -222,7 +222,7 @@ var _ = Describe("mock", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(deployment.APIIdentifier).To(Equal("fake-api-id"))
-			Expect(deployment.State).To(Equal("disabled"))
+			Expect(deployment.State).To(Equal(DeploymentStateDisabled))
 			Expect(deployment.Name).To(Equal("foo"))
 			Expect(deployment.Slug).To(Equal("bar"))
 		})
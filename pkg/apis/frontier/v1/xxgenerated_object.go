@@ -25,3 +25,280 @@ func (o *Deployment) GetIdentifier(ctx context.Context) (string, error) {
 func (o *Endpoint) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the Action object. Values stored in interface fields are copied shallowly.
+func (o *Action) DeepCopy() *Action {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Meta != nil {
+		v1 := *out.Meta
+		if v1.ActionMetaURLRewrite != nil {
+			v3 := *v1.ActionMetaURLRewrite
+			v1.ActionMetaURLRewrite = &v3
+		}
+		if v1.ActionMetaMockResponse != nil {
+			v3 := *v1.ActionMetaMockResponse
+			v1.ActionMetaMockResponse = &v3
+		}
+		if v1.ActionMetaE5EFunction != nil {
+			v3 := *v1.ActionMetaE5EFunction
+			v1.ActionMetaE5EFunction = &v3
+		}
+		if v1.ActionMetaE5EAsyncFunction != nil {
+			v3 := *v1.ActionMetaE5EAsyncFunction
+			v1.ActionMetaE5EAsyncFunction = &v3
+		}
+		if v1.ActionMetaE5EAsyncResult != nil {
+			v3 := *v1.ActionMetaE5EAsyncResult
+			v1.ActionMetaE5EAsyncResult = &v3
+		}
+		out.Meta = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Action object is equal to the given one, comparing all fields deeply.
+func (o *Action) Equal(other *Action) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.EndpointIdentifier != other.EndpointIdentifier {
+		return false
+	}
+	if o.HTTPRequestMethod != other.HTTPRequestMethod {
+		return false
+	}
+	if o.Type != other.Type {
+		return false
+	}
+	if (o.Meta == nil) != (other.Meta == nil) {
+		return false
+	}
+	if o.Meta != nil {
+		if ((*o.Meta).ActionMetaURLRewrite == nil) != ((*other.Meta).ActionMetaURLRewrite == nil) {
+			return false
+		}
+		if (*o.Meta).ActionMetaURLRewrite != nil {
+			if (*(*o.Meta).ActionMetaURLRewrite).URL != (*(*other.Meta).ActionMetaURLRewrite).URL {
+				return false
+			}
+		}
+		if ((*o.Meta).ActionMetaMockResponse == nil) != ((*other.Meta).ActionMetaMockResponse == nil) {
+			return false
+		}
+		if (*o.Meta).ActionMetaMockResponse != nil {
+			if (*(*o.Meta).ActionMetaMockResponse).Body != (*(*other.Meta).ActionMetaMockResponse).Body {
+				return false
+			}
+			if (*(*o.Meta).ActionMetaMockResponse).Language != (*(*other.Meta).ActionMetaMockResponse).Language {
+				return false
+			}
+		}
+		if ((*o.Meta).ActionMetaE5EFunction == nil) != ((*other.Meta).ActionMetaE5EFunction == nil) {
+			return false
+		}
+		if (*o.Meta).ActionMetaE5EFunction != nil {
+			if (*(*o.Meta).ActionMetaE5EFunction).FunctionIdentifier != (*(*other.Meta).ActionMetaE5EFunction).FunctionIdentifier {
+				return false
+			}
+		}
+		if ((*o.Meta).ActionMetaE5EAsyncFunction == nil) != ((*other.Meta).ActionMetaE5EAsyncFunction == nil) {
+			return false
+		}
+		if (*o.Meta).ActionMetaE5EAsyncFunction != nil {
+			if (*(*o.Meta).ActionMetaE5EAsyncFunction).FunctionIdentifier != (*(*other.Meta).ActionMetaE5EAsyncFunction).FunctionIdentifier {
+				return false
+			}
+		}
+		if ((*o.Meta).ActionMetaE5EAsyncResult == nil) != ((*other.Meta).ActionMetaE5EAsyncResult == nil) {
+			return false
+		}
+		if (*o.Meta).ActionMetaE5EAsyncResult != nil {
+			if (*(*o.Meta).ActionMetaE5EAsyncResult).FunctionIdentifier != (*(*other.Meta).ActionMetaE5EAsyncResult).FunctionIdentifier {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ActionFields contains the names of the fields of Action objects, usable with compare.Compare and compare.Reconcile.
+var ActionFields = struct {
+	Identifier         string
+	EndpointIdentifier string
+	HTTPRequestMethod  string
+	Type               string
+	Meta               string
+}{
+	Identifier:         "Identifier",
+	EndpointIdentifier: "EndpointIdentifier",
+	HTTPRequestMethod:  "HTTPRequestMethod",
+	Type:               "Type",
+	Meta:               "Meta",
+}
+
+// DeepCopy returns a deep copy of the API object. Values stored in interface fields are copied shallowly.
+func (o *API) DeepCopy() *API {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Description != nil {
+		v1 := *out.Description
+		out.Description = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the API object is equal to the given one, comparing all fields deeply.
+func (o *API) Equal(other *API) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.Description == nil) != (other.Description == nil) {
+		return false
+	}
+	if o.Description != nil {
+		if (*o.Description) != (*other.Description) {
+			return false
+		}
+	}
+	if o.TransferProtocol != other.TransferProtocol {
+		return false
+	}
+	if o.DeploymentIdentifier != other.DeploymentIdentifier {
+		return false
+	}
+
+	return true
+}
+
+// APIFields contains the names of the fields of API objects, usable with compare.Compare and compare.Reconcile.
+var APIFields = struct {
+	Identifier           string
+	Name                 string
+	Description          string
+	TransferProtocol     string
+	DeploymentIdentifier string
+}{
+	Identifier:           "Identifier",
+	Name:                 "Name",
+	Description:          "Description",
+	TransferProtocol:     "TransferProtocol",
+	DeploymentIdentifier: "DeploymentIdentifier",
+}
+
+// DeepCopy returns a deep copy of the Deployment object. Values stored in interface fields are copied shallowly.
+func (o *Deployment) DeepCopy() *Deployment {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+
+	return &out
+}
+
+// Equal returns if the Deployment object is equal to the given one, comparing all fields deeply.
+func (o *Deployment) Equal(other *Deployment) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.APIIdentifier != other.APIIdentifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Slug != other.Slug {
+		return false
+	}
+	if o.State != other.State {
+		return false
+	}
+
+	return true
+}
+
+// DeploymentFields contains the names of the fields of Deployment objects, usable with compare.Compare and compare.Reconcile.
+var DeploymentFields = struct {
+	Identifier    string
+	APIIdentifier string
+	Name          string
+	Slug          string
+	State         string
+}{
+	Identifier:    "Identifier",
+	APIIdentifier: "APIIdentifier",
+	Name:          "Name",
+	Slug:          "Slug",
+	State:         "State",
+}
+
+// DeepCopy returns a deep copy of the Endpoint object. Values stored in interface fields are copied shallowly.
+func (o *Endpoint) DeepCopy() *Endpoint {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+
+	return &out
+}
+
+// Equal returns if the Endpoint object is equal to the given one, comparing all fields deeply.
+func (o *Endpoint) Equal(other *Endpoint) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Path != other.Path {
+		return false
+	}
+	if o.APIIdentifier != other.APIIdentifier {
+		return false
+	}
+
+	return true
+}
+
+// EndpointFields contains the names of the fields of Endpoint objects, usable with compare.Compare and compare.Reconcile.
+var EndpointFields = struct {
+	Identifier    string
+	Name          string
+	Path          string
+	APIIdentifier string
+}{
+	Identifier:    "Identifier",
+	Name:          "Name",
+	Path:          "Path",
+	APIIdentifier: "APIIdentifier",
+}
@@ -20,6 +20,9 @@ var _ = Describe("Object Action", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ActionFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object API", func() {
@@ -32,6 +35,9 @@ var _ = Describe("Object API", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.APIFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Deployment", func() {
@@ -44,6 +50,8 @@ var _ = Describe("Object Deployment", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.DeploymentFields)
 })
 
 var _ = Describe("Object Endpoint", func() {
@@ -56,4 +64,7 @@ var _ = Describe("Object Endpoint", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.EndpointFields)
+	testutils.ContractTests(&o)
 })
@@ -6,7 +6,7 @@ import (
 	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
 )
 
-// anxcloud:object
+// anxcloud:object:resourcediscovery:contract=clusterContractOptions
 
 // Cluster represents a Kubernetes cluster
 // This resource does not support updates
@@ -17,11 +17,11 @@ type Cluster struct {
 	// Identifier of the cluster
 	Identifier string `json:"identifier,omitempty" anxcloud:"identifier"`
 	// Name of the cluster. Must be an RFC 1123 hostname in lowercase
-	Name string `json:"name,omitempty"`
+	Name string `json:"name,omitempty" validate:"create,required,hostname,lowercase"`
 	// Kubernetes version to be used for the cluster. We recommend to use the default value.
 	Version string `json:"version,omitempty"`
 	// Location where the cluster will be deployed
-	Location corev1.Location `json:"location,omitempty"`
+	Location corev1.Location `json:"location,omitempty" validate:"create,required"`
 	// If set to true, Service VMs providing load balancers and outbound masquerade are created for this cluster.
 	// Default: true. Optional value can be set via pkg/utils/pointer.Bool
 	NeedsServiceVMs *bool `json:"needs_service_vms,omitempty"`
@@ -72,7 +72,7 @@ type Cluster struct {
 	OidcUsernamePrefix       string `json:"oidc_username_prefix,omitempty"`
 
 	// Contains a kubeconfig if available
-	KubeConfig *string `json:"kubeconfig,omitempty"`
+	KubeConfig *string `json:"kubeconfig,omitempty" anxcloud:"secret"`
 
 	// Enable autoscaling for this cluster. You will need to explicitly configure
 	// your node pools for autoscaling, please refer to the provided [Autoscaling documentation]
@@ -83,7 +83,7 @@ type Cluster struct {
 
 	// Container Network Interface plugin to be installed on the cluster.
 	// Only the default value is supported. Default: canal = Canal
-	CniPlugin string `json:"cni_plugin,omitempty"`
+	CniPlugin string `json:"cni_plugin,omitempty" validate:"enum=canal"`
 
 	// Space-separated list of IP networks in CIDR notation, which are allowed to access the cluster's API server.
 	// If left empty, there will be no IP address-based restrictions.
@@ -92,7 +92,7 @@ type Cluster struct {
 	// IP families to use for external networking.
 	// Addresses from the selected protocols will be allocated for the cluster's Service VMs and load balancing,
 	// if those are enabled. One of: IPv4 = IPv4, DualStack = IPv4 & IPv6. Default: DualStack
-	ExternalIPFamilies string `json:"external_ip_families,omitempty"`
+	ExternalIPFamilies string `json:"external_ip_families,omitempty" validate:"enum=IPv4|DualStack"`
 
 	ServiceUser *common.PartialResource `json:"service_user,omitempty"`
 
@@ -0,0 +1,21 @@
+package v1_test
+
+import (
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	apipkg "go.anx.io/go-anxcloud/pkg/apis/kubernetes/v1"
+	"go.anx.io/go-anxcloud/pkg/utils/pointer"
+	testutils "go.anx.io/go-anxcloud/pkg/utils/test"
+)
+
+// clusterContractOptions configures the contract tests of Clusters, which cannot be created with prefixes set to
+// be managed.
+func clusterContractOptions() []testutils.ContractOption {
+	return []testutils.ContractOption{
+		testutils.ContractPrepare(func(o types.Object, _ types.Operation) {
+			c := o.(*apipkg.Cluster)
+			c.ManageInternalIPv4Prefix = pointer.Bool(false)
+			c.ManageExternalIPv4Prefix = pointer.Bool(false)
+			c.ManageExternalIPv6Prefix = pointer.Bool(false)
+		}),
+	}
+}
@@ -84,7 +84,10 @@ var _ = Describe("CRUD", Ordered, func() {
 				ghttp.VerifyRequest("POST", "/api/kubernetes-test/v1/cluster.json"),
 				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]any{}),
 			))
-			Expect(a.Create(context.TODO(), &Cluster{})).To(Succeed())
+			Expect(a.Create(context.TODO(), &Cluster{
+				Name:     "environment-test",
+				Location: corev1.Location{Identifier: "location-identifier"},
+			})).To(Succeed())
 		})
 
 		It("correctly applies the environment to the node pool resource path", func() {
@@ -12,7 +12,7 @@ const (
 	FlatcarLinux OperatingSystem = "Flatcar Linux"
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcediscovery
 
 // NodePool represents a Kubernetes node pool
 // This resource does not support updates
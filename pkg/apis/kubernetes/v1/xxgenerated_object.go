@@ -4,6 +4,8 @@ package v1
 
 import (
 	"context"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
 )
 
 // GetIdentifier returns the primary identifier of a Cluster object
@@ -20,3 +22,779 @@ func (o *kubeconfig) GetIdentifier(ctx context.Context) (string, error) {
 func (o *NodePool) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+func init() {
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+		return &Cluster{Identifier: identifier}
+	})
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+		return &NodePool{Identifier: identifier}
+	})
+}
+
+// DeepCopy returns a deep copy of the Cluster object. Values stored in interface fields are copied shallowly.
+func (o *Cluster) DeepCopy() *Cluster {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Location = *out.Location.DeepCopy()
+	if out.NeedsServiceVMs != nil {
+		v1 := *out.NeedsServiceVMs
+		out.NeedsServiceVMs = &v1
+	}
+	if out.EnableNATGateways != nil {
+		v1 := *out.EnableNATGateways
+		out.EnableNATGateways = &v1
+	}
+	if out.EnableLBaaS != nil {
+		v1 := *out.EnableLBaaS
+		out.EnableLBaaS = &v1
+	}
+	if out.InternalIPv4Prefix != nil {
+		v1 := *out.InternalIPv4Prefix
+		out.InternalIPv4Prefix = &v1
+	}
+	if out.ExternalIPv4Prefix != nil {
+		v1 := *out.ExternalIPv4Prefix
+		out.ExternalIPv4Prefix = &v1
+	}
+	if out.ExternalIPv6Prefix != nil {
+		v1 := *out.ExternalIPv6Prefix
+		out.ExternalIPv6Prefix = &v1
+	}
+	if out.ManageInternalIPv4Prefix != nil {
+		v1 := *out.ManageInternalIPv4Prefix
+		out.ManageInternalIPv4Prefix = &v1
+	}
+	if out.ManageExternalIPv4Prefix != nil {
+		v1 := *out.ManageExternalIPv4Prefix
+		out.ManageExternalIPv4Prefix = &v1
+	}
+	if out.ManageExternalIPv6Prefix != nil {
+		v1 := *out.ManageExternalIPv6Prefix
+		out.ManageExternalIPv6Prefix = &v1
+	}
+	if out.ExternalVlan != nil {
+		v1 := *out.ExternalVlan
+		out.ExternalVlan = &v1
+	}
+	if out.InternalVlan != nil {
+		v1 := *out.InternalVlan
+		out.InternalVlan = &v1
+	}
+	if out.KkpProjectID != nil {
+		v1 := *out.KkpProjectID
+		out.KkpProjectID = &v1
+	}
+	if out.KkpClusterID != nil {
+		v1 := *out.KkpClusterID
+		out.KkpClusterID = &v1
+	}
+	if out.KubeConfig != nil {
+		v1 := *out.KubeConfig
+		out.KubeConfig = &v1
+	}
+	if out.EnableAutoscaling != nil {
+		v1 := *out.EnableAutoscaling
+		out.EnableAutoscaling = &v1
+	}
+	if out.ServiceUser != nil {
+		v1 := *out.ServiceUser
+		out.ServiceUser = &v1
+	}
+	if out.ServiceVM01Identifier != nil {
+		v1 := *out.ServiceVM01Identifier
+		out.ServiceVM01Identifier = &v1
+	}
+	if out.ServiceVM02Identifier != nil {
+		v1 := *out.ServiceVM02Identifier
+		out.ServiceVM02Identifier = &v1
+	}
+	if out.ServiceVM01InternalIPv4Address != nil {
+		v1 := *out.ServiceVM01InternalIPv4Address
+		out.ServiceVM01InternalIPv4Address = &v1
+	}
+	if out.ServiceVM02InternalIPv4Address != nil {
+		v1 := *out.ServiceVM02InternalIPv4Address
+		out.ServiceVM02InternalIPv4Address = &v1
+	}
+	if out.ServiceVM01ExternalIPv4Address != nil {
+		v1 := *out.ServiceVM01ExternalIPv4Address
+		out.ServiceVM01ExternalIPv4Address = &v1
+	}
+	if out.ServiceVM02ExternalIPv4Address != nil {
+		v1 := *out.ServiceVM02ExternalIPv4Address
+		out.ServiceVM02ExternalIPv4Address = &v1
+	}
+	if out.ServiceVM01ExternalIPv6Address != nil {
+		v1 := *out.ServiceVM01ExternalIPv6Address
+		out.ServiceVM01ExternalIPv6Address = &v1
+	}
+	if out.ServiceVM02ExternalIPv6Address != nil {
+		v1 := *out.ServiceVM02ExternalIPv6Address
+		out.ServiceVM02ExternalIPv6Address = &v1
+	}
+	if out.ServiceLB01 != nil {
+		v1 := *out.ServiceLB01
+		out.ServiceLB01 = &v1
+	}
+	if out.ServiceLB02 != nil {
+		v1 := *out.ServiceLB02
+		out.ServiceLB02 = &v1
+	}
+	if out.ExternalVIPv4 != nil {
+		v1 := *out.ExternalVIPv4
+		out.ExternalVIPv4 = &v1
+	}
+	if out.ExternalVIPv6 != nil {
+		v1 := *out.ExternalVIPv6
+		out.ExternalVIPv6 = &v1
+	}
+	if out.KKPAPILBaaSBackend01 != nil {
+		v1 := *out.KKPAPILBaaSBackend01
+		out.KKPAPILBaaSBackend01 = &v1
+	}
+	if out.KKPAPILBaaSBackend02 != nil {
+		v1 := *out.KKPAPILBaaSBackend02
+		out.KKPAPILBaaSBackend02 = &v1
+	}
+	if out.KKPVPNLBaaSBackend01 != nil {
+		v1 := *out.KKPVPNLBaaSBackend01
+		out.KKPVPNLBaaSBackend01 = &v1
+	}
+	if out.KKPVPNLBaaSBackend02 != nil {
+		v1 := *out.KKPVPNLBaaSBackend02
+		out.KKPVPNLBaaSBackend02 = &v1
+	}
+	if out.StorageServerInterfaceAddress != nil {
+		v1 := *out.StorageServerInterfaceAddress
+		out.StorageServerInterfaceAddress = &v1
+	}
+	if out.StorageServerInterface != nil {
+		v1 := *out.StorageServerInterface
+		out.StorageServerInterface = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Cluster object is equal to the given one, comparing all fields deeply.
+func (o *Cluster) Equal(other *Cluster) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Version != other.Version {
+		return false
+	}
+	if !o.Location.Equal(&other.Location) {
+		return false
+	}
+	if (o.NeedsServiceVMs == nil) != (other.NeedsServiceVMs == nil) {
+		return false
+	}
+	if o.NeedsServiceVMs != nil {
+		if (*o.NeedsServiceVMs) != (*other.NeedsServiceVMs) {
+			return false
+		}
+	}
+	if (o.EnableNATGateways == nil) != (other.EnableNATGateways == nil) {
+		return false
+	}
+	if o.EnableNATGateways != nil {
+		if (*o.EnableNATGateways) != (*other.EnableNATGateways) {
+			return false
+		}
+	}
+	if (o.EnableLBaaS == nil) != (other.EnableLBaaS == nil) {
+		return false
+	}
+	if o.EnableLBaaS != nil {
+		if (*o.EnableLBaaS) != (*other.EnableLBaaS) {
+			return false
+		}
+	}
+	if (o.InternalIPv4Prefix == nil) != (other.InternalIPv4Prefix == nil) {
+		return false
+	}
+	if o.InternalIPv4Prefix != nil {
+		if (*o.InternalIPv4Prefix).Identifier != (*other.InternalIPv4Prefix).Identifier {
+			return false
+		}
+		if (*o.InternalIPv4Prefix).Name != (*other.InternalIPv4Prefix).Name {
+			return false
+		}
+	}
+	if (o.ExternalIPv4Prefix == nil) != (other.ExternalIPv4Prefix == nil) {
+		return false
+	}
+	if o.ExternalIPv4Prefix != nil {
+		if (*o.ExternalIPv4Prefix).Identifier != (*other.ExternalIPv4Prefix).Identifier {
+			return false
+		}
+		if (*o.ExternalIPv4Prefix).Name != (*other.ExternalIPv4Prefix).Name {
+			return false
+		}
+	}
+	if (o.ExternalIPv6Prefix == nil) != (other.ExternalIPv6Prefix == nil) {
+		return false
+	}
+	if o.ExternalIPv6Prefix != nil {
+		if (*o.ExternalIPv6Prefix).Identifier != (*other.ExternalIPv6Prefix).Identifier {
+			return false
+		}
+		if (*o.ExternalIPv6Prefix).Name != (*other.ExternalIPv6Prefix).Name {
+			return false
+		}
+	}
+	if (o.ManageInternalIPv4Prefix == nil) != (other.ManageInternalIPv4Prefix == nil) {
+		return false
+	}
+	if o.ManageInternalIPv4Prefix != nil {
+		if (*o.ManageInternalIPv4Prefix) != (*other.ManageInternalIPv4Prefix) {
+			return false
+		}
+	}
+	if (o.ManageExternalIPv4Prefix == nil) != (other.ManageExternalIPv4Prefix == nil) {
+		return false
+	}
+	if o.ManageExternalIPv4Prefix != nil {
+		if (*o.ManageExternalIPv4Prefix) != (*other.ManageExternalIPv4Prefix) {
+			return false
+		}
+	}
+	if (o.ManageExternalIPv6Prefix == nil) != (other.ManageExternalIPv6Prefix == nil) {
+		return false
+	}
+	if o.ManageExternalIPv6Prefix != nil {
+		if (*o.ManageExternalIPv6Prefix) != (*other.ManageExternalIPv6Prefix) {
+			return false
+		}
+	}
+	if (o.ExternalVlan == nil) != (other.ExternalVlan == nil) {
+		return false
+	}
+	if o.ExternalVlan != nil {
+		if (*o.ExternalVlan).Identifier != (*other.ExternalVlan).Identifier {
+			return false
+		}
+		if (*o.ExternalVlan).Name != (*other.ExternalVlan).Name {
+			return false
+		}
+	}
+	if (o.InternalVlan == nil) != (other.InternalVlan == nil) {
+		return false
+	}
+	if o.InternalVlan != nil {
+		if (*o.InternalVlan).Identifier != (*other.InternalVlan).Identifier {
+			return false
+		}
+		if (*o.InternalVlan).Name != (*other.InternalVlan).Name {
+			return false
+		}
+	}
+	if (o.KkpProjectID == nil) != (other.KkpProjectID == nil) {
+		return false
+	}
+	if o.KkpProjectID != nil {
+		if (*o.KkpProjectID) != (*other.KkpProjectID) {
+			return false
+		}
+	}
+	if (o.KkpClusterID == nil) != (other.KkpClusterID == nil) {
+		return false
+	}
+	if o.KkpClusterID != nil {
+		if (*o.KkpClusterID) != (*other.KkpClusterID) {
+			return false
+		}
+	}
+	if o.EnableOidcAuthentication != other.EnableOidcAuthentication {
+		return false
+	}
+	if o.OidcClientId != other.OidcClientId {
+		return false
+	}
+	if o.OidcIssuerUrl != other.OidcIssuerUrl {
+		return false
+	}
+	if o.OidcGroupsClaim != other.OidcGroupsClaim {
+		return false
+	}
+	if o.OidcUsernameClaim != other.OidcUsernameClaim {
+		return false
+	}
+	if o.OidcExtraScopes != other.OidcExtraScopes {
+		return false
+	}
+	if o.OidcGroupsPrefix != other.OidcGroupsPrefix {
+		return false
+	}
+	if o.OidcRequiredClaim != other.OidcRequiredClaim {
+		return false
+	}
+	if o.OidcUsernamePrefix != other.OidcUsernamePrefix {
+		return false
+	}
+	if (o.KubeConfig == nil) != (other.KubeConfig == nil) {
+		return false
+	}
+	if o.KubeConfig != nil {
+		if (*o.KubeConfig) != (*other.KubeConfig) {
+			return false
+		}
+	}
+	if (o.EnableAutoscaling == nil) != (other.EnableAutoscaling == nil) {
+		return false
+	}
+	if o.EnableAutoscaling != nil {
+		if (*o.EnableAutoscaling) != (*other.EnableAutoscaling) {
+			return false
+		}
+	}
+	if o.CniPlugin != other.CniPlugin {
+		return false
+	}
+	if o.ApiServerAllowlist != other.ApiServerAllowlist {
+		return false
+	}
+	if o.ExternalIPFamilies != other.ExternalIPFamilies {
+		return false
+	}
+	if (o.ServiceUser == nil) != (other.ServiceUser == nil) {
+		return false
+	}
+	if o.ServiceUser != nil {
+		if (*o.ServiceUser).Identifier != (*other.ServiceUser).Identifier {
+			return false
+		}
+		if (*o.ServiceUser).Name != (*other.ServiceUser).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM01Identifier == nil) != (other.ServiceVM01Identifier == nil) {
+		return false
+	}
+	if o.ServiceVM01Identifier != nil {
+		if (*o.ServiceVM01Identifier).Identifier != (*other.ServiceVM01Identifier).Identifier {
+			return false
+		}
+		if (*o.ServiceVM01Identifier).Name != (*other.ServiceVM01Identifier).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM02Identifier == nil) != (other.ServiceVM02Identifier == nil) {
+		return false
+	}
+	if o.ServiceVM02Identifier != nil {
+		if (*o.ServiceVM02Identifier).Identifier != (*other.ServiceVM02Identifier).Identifier {
+			return false
+		}
+		if (*o.ServiceVM02Identifier).Name != (*other.ServiceVM02Identifier).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM01InternalIPv4Address == nil) != (other.ServiceVM01InternalIPv4Address == nil) {
+		return false
+	}
+	if o.ServiceVM01InternalIPv4Address != nil {
+		if (*o.ServiceVM01InternalIPv4Address).Identifier != (*other.ServiceVM01InternalIPv4Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM01InternalIPv4Address).Name != (*other.ServiceVM01InternalIPv4Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM02InternalIPv4Address == nil) != (other.ServiceVM02InternalIPv4Address == nil) {
+		return false
+	}
+	if o.ServiceVM02InternalIPv4Address != nil {
+		if (*o.ServiceVM02InternalIPv4Address).Identifier != (*other.ServiceVM02InternalIPv4Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM02InternalIPv4Address).Name != (*other.ServiceVM02InternalIPv4Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM01ExternalIPv4Address == nil) != (other.ServiceVM01ExternalIPv4Address == nil) {
+		return false
+	}
+	if o.ServiceVM01ExternalIPv4Address != nil {
+		if (*o.ServiceVM01ExternalIPv4Address).Identifier != (*other.ServiceVM01ExternalIPv4Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM01ExternalIPv4Address).Name != (*other.ServiceVM01ExternalIPv4Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM02ExternalIPv4Address == nil) != (other.ServiceVM02ExternalIPv4Address == nil) {
+		return false
+	}
+	if o.ServiceVM02ExternalIPv4Address != nil {
+		if (*o.ServiceVM02ExternalIPv4Address).Identifier != (*other.ServiceVM02ExternalIPv4Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM02ExternalIPv4Address).Name != (*other.ServiceVM02ExternalIPv4Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM01ExternalIPv6Address == nil) != (other.ServiceVM01ExternalIPv6Address == nil) {
+		return false
+	}
+	if o.ServiceVM01ExternalIPv6Address != nil {
+		if (*o.ServiceVM01ExternalIPv6Address).Identifier != (*other.ServiceVM01ExternalIPv6Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM01ExternalIPv6Address).Name != (*other.ServiceVM01ExternalIPv6Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceVM02ExternalIPv6Address == nil) != (other.ServiceVM02ExternalIPv6Address == nil) {
+		return false
+	}
+	if o.ServiceVM02ExternalIPv6Address != nil {
+		if (*o.ServiceVM02ExternalIPv6Address).Identifier != (*other.ServiceVM02ExternalIPv6Address).Identifier {
+			return false
+		}
+		if (*o.ServiceVM02ExternalIPv6Address).Name != (*other.ServiceVM02ExternalIPv6Address).Name {
+			return false
+		}
+	}
+	if (o.ServiceLB01 == nil) != (other.ServiceLB01 == nil) {
+		return false
+	}
+	if o.ServiceLB01 != nil {
+		if (*o.ServiceLB01).Identifier != (*other.ServiceLB01).Identifier {
+			return false
+		}
+		if (*o.ServiceLB01).Name != (*other.ServiceLB01).Name {
+			return false
+		}
+	}
+	if (o.ServiceLB02 == nil) != (other.ServiceLB02 == nil) {
+		return false
+	}
+	if o.ServiceLB02 != nil {
+		if (*o.ServiceLB02).Identifier != (*other.ServiceLB02).Identifier {
+			return false
+		}
+		if (*o.ServiceLB02).Name != (*other.ServiceLB02).Name {
+			return false
+		}
+	}
+	if (o.ExternalVIPv4 == nil) != (other.ExternalVIPv4 == nil) {
+		return false
+	}
+	if o.ExternalVIPv4 != nil {
+		if (*o.ExternalVIPv4).Identifier != (*other.ExternalVIPv4).Identifier {
+			return false
+		}
+		if (*o.ExternalVIPv4).Name != (*other.ExternalVIPv4).Name {
+			return false
+		}
+	}
+	if (o.ExternalVIPv6 == nil) != (other.ExternalVIPv6 == nil) {
+		return false
+	}
+	if o.ExternalVIPv6 != nil {
+		if (*o.ExternalVIPv6).Identifier != (*other.ExternalVIPv6).Identifier {
+			return false
+		}
+		if (*o.ExternalVIPv6).Name != (*other.ExternalVIPv6).Name {
+			return false
+		}
+	}
+	if (o.KKPAPILBaaSBackend01 == nil) != (other.KKPAPILBaaSBackend01 == nil) {
+		return false
+	}
+	if o.KKPAPILBaaSBackend01 != nil {
+		if (*o.KKPAPILBaaSBackend01).Identifier != (*other.KKPAPILBaaSBackend01).Identifier {
+			return false
+		}
+		if (*o.KKPAPILBaaSBackend01).Name != (*other.KKPAPILBaaSBackend01).Name {
+			return false
+		}
+	}
+	if (o.KKPAPILBaaSBackend02 == nil) != (other.KKPAPILBaaSBackend02 == nil) {
+		return false
+	}
+	if o.KKPAPILBaaSBackend02 != nil {
+		if (*o.KKPAPILBaaSBackend02).Identifier != (*other.KKPAPILBaaSBackend02).Identifier {
+			return false
+		}
+		if (*o.KKPAPILBaaSBackend02).Name != (*other.KKPAPILBaaSBackend02).Name {
+			return false
+		}
+	}
+	if (o.KKPVPNLBaaSBackend01 == nil) != (other.KKPVPNLBaaSBackend01 == nil) {
+		return false
+	}
+	if o.KKPVPNLBaaSBackend01 != nil {
+		if (*o.KKPVPNLBaaSBackend01).Identifier != (*other.KKPVPNLBaaSBackend01).Identifier {
+			return false
+		}
+		if (*o.KKPVPNLBaaSBackend01).Name != (*other.KKPVPNLBaaSBackend01).Name {
+			return false
+		}
+	}
+	if (o.KKPVPNLBaaSBackend02 == nil) != (other.KKPVPNLBaaSBackend02 == nil) {
+		return false
+	}
+	if o.KKPVPNLBaaSBackend02 != nil {
+		if (*o.KKPVPNLBaaSBackend02).Identifier != (*other.KKPVPNLBaaSBackend02).Identifier {
+			return false
+		}
+		if (*o.KKPVPNLBaaSBackend02).Name != (*other.KKPVPNLBaaSBackend02).Name {
+			return false
+		}
+	}
+	if o.BackendName != other.BackendName {
+		return false
+	}
+	if o.Backend != other.Backend {
+		return false
+	}
+	if (o.StorageServerInterfaceAddress == nil) != (other.StorageServerInterfaceAddress == nil) {
+		return false
+	}
+	if o.StorageServerInterfaceAddress != nil {
+		if (*o.StorageServerInterfaceAddress).Identifier != (*other.StorageServerInterfaceAddress).Identifier {
+			return false
+		}
+		if (*o.StorageServerInterfaceAddress).Name != (*other.StorageServerInterfaceAddress).Name {
+			return false
+		}
+	}
+	if (o.StorageServerInterface == nil) != (other.StorageServerInterface == nil) {
+		return false
+	}
+	if o.StorageServerInterface != nil {
+		if (*o.StorageServerInterface).Identifier != (*other.StorageServerInterface).Identifier {
+			return false
+		}
+		if (*o.StorageServerInterface).Name != (*other.StorageServerInterface).Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ClusterFields contains the names of the fields of Cluster objects, usable with compare.Compare and compare.Reconcile.
+var ClusterFields = struct {
+	GenericService                 string
+	HasState                       string
+	Identifier                     string
+	Name                           string
+	Version                        string
+	Location                       string
+	NeedsServiceVMs                string
+	EnableNATGateways              string
+	EnableLBaaS                    string
+	InternalIPv4Prefix             string
+	ExternalIPv4Prefix             string
+	ExternalIPv6Prefix             string
+	ManageInternalIPv4Prefix       string
+	ManageExternalIPv4Prefix       string
+	ManageExternalIPv6Prefix       string
+	ExternalVlan                   string
+	InternalVlan                   string
+	KkpProjectID                   string
+	KkpClusterID                   string
+	EnableOidcAuthentication       string
+	OidcClientId                   string
+	OidcIssuerUrl                  string
+	OidcGroupsClaim                string
+	OidcUsernameClaim              string
+	OidcExtraScopes                string
+	OidcGroupsPrefix               string
+	OidcRequiredClaim              string
+	OidcUsernamePrefix             string
+	KubeConfig                     string
+	EnableAutoscaling              string
+	CniPlugin                      string
+	ApiServerAllowlist             string
+	ExternalIPFamilies             string
+	ServiceUser                    string
+	ServiceVM01Identifier          string
+	ServiceVM02Identifier          string
+	ServiceVM01InternalIPv4Address string
+	ServiceVM02InternalIPv4Address string
+	ServiceVM01ExternalIPv4Address string
+	ServiceVM02ExternalIPv4Address string
+	ServiceVM01ExternalIPv6Address string
+	ServiceVM02ExternalIPv6Address string
+	ServiceLB01                    string
+	ServiceLB02                    string
+	ExternalVIPv4                  string
+	ExternalVIPv6                  string
+	KKPAPILBaaSBackend01           string
+	KKPAPILBaaSBackend02           string
+	KKPVPNLBaaSBackend01           string
+	KKPVPNLBaaSBackend02           string
+	BackendName                    string
+	Backend                        string
+	StorageServerInterfaceAddress  string
+	StorageServerInterface         string
+}{
+	GenericService:                 "GenericService",
+	HasState:                       "HasState",
+	Identifier:                     "Identifier",
+	Name:                           "Name",
+	Version:                        "Version",
+	Location:                       "Location",
+	NeedsServiceVMs:                "NeedsServiceVMs",
+	EnableNATGateways:              "EnableNATGateways",
+	EnableLBaaS:                    "EnableLBaaS",
+	InternalIPv4Prefix:             "InternalIPv4Prefix",
+	ExternalIPv4Prefix:             "ExternalIPv4Prefix",
+	ExternalIPv6Prefix:             "ExternalIPv6Prefix",
+	ManageInternalIPv4Prefix:       "ManageInternalIPv4Prefix",
+	ManageExternalIPv4Prefix:       "ManageExternalIPv4Prefix",
+	ManageExternalIPv6Prefix:       "ManageExternalIPv6Prefix",
+	ExternalVlan:                   "ExternalVlan",
+	InternalVlan:                   "InternalVlan",
+	KkpProjectID:                   "KkpProjectID",
+	KkpClusterID:                   "KkpClusterID",
+	EnableOidcAuthentication:       "EnableOidcAuthentication",
+	OidcClientId:                   "OidcClientId",
+	OidcIssuerUrl:                  "OidcIssuerUrl",
+	OidcGroupsClaim:                "OidcGroupsClaim",
+	OidcUsernameClaim:              "OidcUsernameClaim",
+	OidcExtraScopes:                "OidcExtraScopes",
+	OidcGroupsPrefix:               "OidcGroupsPrefix",
+	OidcRequiredClaim:              "OidcRequiredClaim",
+	OidcUsernamePrefix:             "OidcUsernamePrefix",
+	KubeConfig:                     "KubeConfig",
+	EnableAutoscaling:              "EnableAutoscaling",
+	CniPlugin:                      "CniPlugin",
+	ApiServerAllowlist:             "ApiServerAllowlist",
+	ExternalIPFamilies:             "ExternalIPFamilies",
+	ServiceUser:                    "ServiceUser",
+	ServiceVM01Identifier:          "ServiceVM01Identifier",
+	ServiceVM02Identifier:          "ServiceVM02Identifier",
+	ServiceVM01InternalIPv4Address: "ServiceVM01InternalIPv4Address",
+	ServiceVM02InternalIPv4Address: "ServiceVM02InternalIPv4Address",
+	ServiceVM01ExternalIPv4Address: "ServiceVM01ExternalIPv4Address",
+	ServiceVM02ExternalIPv4Address: "ServiceVM02ExternalIPv4Address",
+	ServiceVM01ExternalIPv6Address: "ServiceVM01ExternalIPv6Address",
+	ServiceVM02ExternalIPv6Address: "ServiceVM02ExternalIPv6Address",
+	ServiceLB01:                    "ServiceLB01",
+	ServiceLB02:                    "ServiceLB02",
+	ExternalVIPv4:                  "ExternalVIPv4",
+	ExternalVIPv6:                  "ExternalVIPv6",
+	KKPAPILBaaSBackend01:           "KKPAPILBaaSBackend01",
+	KKPAPILBaaSBackend02:           "KKPAPILBaaSBackend02",
+	KKPVPNLBaaSBackend01:           "KKPVPNLBaaSBackend01",
+	KKPVPNLBaaSBackend02:           "KKPVPNLBaaSBackend02",
+	BackendName:                    "BackendName",
+	Backend:                        "Backend",
+	StorageServerInterfaceAddress:  "StorageServerInterfaceAddress",
+	StorageServerInterface:         "StorageServerInterface",
+}
+
+// DeepCopy returns a deep copy of the NodePool object. Values stored in interface fields are copied shallowly.
+func (o *NodePool) DeepCopy() *NodePool {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Cluster = *out.Cluster.DeepCopy()
+	if out.Replicas != nil {
+		v1 := *out.Replicas
+		out.Replicas = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the NodePool object is equal to the given one, comparing all fields deeply.
+func (o *NodePool) Equal(other *NodePool) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if !o.Cluster.Equal(&other.Cluster) {
+		return false
+	}
+	if (o.Replicas == nil) != (other.Replicas == nil) {
+		return false
+	}
+	if o.Replicas != nil {
+		if (*o.Replicas) != (*other.Replicas) {
+			return false
+		}
+	}
+	if o.CPUs != other.CPUs {
+		return false
+	}
+	if o.Memory != other.Memory {
+		return false
+	}
+	if o.DiskSize != other.DiskSize {
+		return false
+	}
+	if o.OperatingSystem != other.OperatingSystem {
+		return false
+	}
+
+	return true
+}
+
+// NodePoolFields contains the names of the fields of NodePool objects, usable with compare.Compare and compare.Reconcile.
+var NodePoolFields = struct {
+	GenericService  string
+	HasState        string
+	Identifier      string
+	Name            string
+	Cluster         string
+	Replicas        string
+	CPUs            string
+	Memory          string
+	DiskSize        string
+	OperatingSystem string
+}{
+	GenericService:  "GenericService",
+	HasState:        "HasState",
+	Identifier:      "Identifier",
+	Name:            "Name",
+	Cluster:         "Cluster",
+	Replicas:        "Replicas",
+	CPUs:            "CPUs",
+	Memory:          "Memory",
+	DiskSize:        "DiskSize",
+	OperatingSystem: "OperatingSystem",
+}
@@ -20,6 +20,9 @@ var _ = Describe("Object Cluster", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ClusterFields)
+	testutils.ContractTests(&o, clusterContractOptions()...)
 })
 
 var _ = Describe("Object NodePool", func() {
@@ -36,4 +39,7 @@ var _ = Describe("Object NodePool", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.NodePoolFields)
+	testutils.ContractTests(&o)
 })
@@ -2,7 +2,7 @@ package v1
 
 import "go.anx.io/go-anxcloud/pkg/apis/common/gs"
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcediscovery
 
 // ACL represents an LBaaS ACL
 type ACL struct {
@@ -2,7 +2,7 @@ package v1
 
 import "go.anx.io/go-anxcloud/pkg/apis/common/gs"
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcetype=33164a3066a04a52be43c607f0c5dd8c
 
 // The Backend resource configures settings common for all specific backend Server resources linked to it.
 type Backend struct {
@@ -12,12 +12,12 @@ type Backend struct {
 	CustomerIdentifier string     `json:"customer_identifier,omitempty"`
 	ResellerIdentifier string     `json:"reseller_identifier,omitempty"`
 	Identifier         string     `json:"identifier,omitempty" anxcloud:"identifier"`
-	Name               string     `json:"name"`
+	Name               string     `json:"name" validate:"create,required"`
 	HealthCheck        string     `json:"health_check,omitempty"`
-	Mode               Mode       `json:"mode"`
+	Mode               Mode       `json:"mode" validate:"enum=tcp|http"`
 	ServerTimeout      int        `json:"server_timeout,omitempty"`
 	AutomationRules    []RuleInfo `json:"automation_rules,omitempty"`
 
 	// Only the name and identifier fields are used and returned.
-	LoadBalancer LoadBalancer `json:"load_balancer"`
+	LoadBalancer LoadBalancer `json:"load_balancer" validate:"create,required"`
 }
@@ -2,7 +2,7 @@ package v1
 
 import "go.anx.io/go-anxcloud/pkg/apis/common/gs"
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcetype=bd24def982aa478fb3352cb5f49aab47
 
 // Bind represents an LBaaS FrontendBind
 type Bind struct {
@@ -2,7 +2,7 @@ package v1
 
 import "go.anx.io/go-anxcloud/pkg/apis/common/gs"
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcetype=da9d14b9d95840c08213de67f9cee6e2
 
 // Frontend represents a LBaaS Frontend.
 type Frontend struct {
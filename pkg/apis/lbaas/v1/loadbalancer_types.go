@@ -4,7 +4,7 @@ import (
 	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
 )
 
-// anxcloud:object:hooks=RequestBodyHook,ResponseFilterHook
+// anxcloud:object:hooks=RequestBodyHook,ResponseFilterHook:resourcediscovery
 
 // LoadBalancer holds the information of a load balancer instance.
 type LoadBalancer struct {
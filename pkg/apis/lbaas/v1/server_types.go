@@ -2,7 +2,7 @@ package v1
 
 import "go.anx.io/go-anxcloud/pkg/apis/common/gs"
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcetype=01f321a4875446409d7d8469503a905f
 
 // Server holds the information of a load balancers backend server
 type Server struct {
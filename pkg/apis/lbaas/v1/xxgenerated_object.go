@@ -4,6 +4,9 @@ package v1
 
 import (
 	"context"
+	"slices"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
 )
 
 // GetIdentifier returns the primary identifier of a ACL object
@@ -45,3 +48,731 @@ func (o *Server) GetIdentifier(ctx context.Context) (string, error) {
 func (o *RuleInfo) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+func init() {
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+		return &ACL{Identifier: identifier}
+	})
+	types.RegisterResourceType("33164a3066a04a52be43c607f0c5dd8c", func(identifier, name string) types.Object {
+		return &Backend{Identifier: identifier}
+	})
+	types.RegisterResourceType("bd24def982aa478fb3352cb5f49aab47", func(identifier, name string) types.Object {
+		return &Bind{Identifier: identifier}
+	})
+	types.RegisterResourceType("da9d14b9d95840c08213de67f9cee6e2", func(identifier, name string) types.Object {
+		return &Frontend{Identifier: identifier}
+	})
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+		return &LoadBalancer{Identifier: identifier}
+	})
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+		return &Rule{Identifier: identifier}
+	})
+	types.RegisterResourceType("01f321a4875446409d7d8469503a905f", func(identifier, name string) types.Object {
+		return &Server{Identifier: identifier}
+	})
+}
+
+// DeepCopy returns a deep copy of the ACL object. Values stored in interface fields are copied shallowly.
+func (o *ACL) DeepCopy() *ACL {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	if out.Index != nil {
+		v1 := *out.Index
+		out.Index = &v1
+	}
+	out.Frontend = *out.Frontend.DeepCopy()
+	out.Backend = *out.Backend.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the ACL object is equal to the given one, comparing all fields deeply.
+func (o *ACL) Equal(other *ACL) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.ParentType != other.ParentType {
+		return false
+	}
+	if o.Criterion != other.Criterion {
+		return false
+	}
+	if o.Value != other.Value {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+	if (o.Index == nil) != (other.Index == nil) {
+		return false
+	}
+	if o.Index != nil {
+		if (*o.Index) != (*other.Index) {
+			return false
+		}
+	}
+	if !o.Frontend.Equal(&other.Frontend) {
+		return false
+	}
+	if !o.Backend.Equal(&other.Backend) {
+		return false
+	}
+
+	return true
+}
+
+// ACLFields contains the names of the fields of ACL objects, usable with compare.Compare and compare.Reconcile.
+var ACLFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	ParentType         string
+	Criterion          string
+	Value              string
+	AutomationRules    string
+	Index              string
+	Frontend           string
+	Backend            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	ParentType:         "ParentType",
+	Criterion:          "Criterion",
+	Value:              "Value",
+	AutomationRules:    "AutomationRules",
+	Index:              "Index",
+	Frontend:           "Frontend",
+	Backend:            "Backend",
+}
+
+// DeepCopy returns a deep copy of the Backend object. Values stored in interface fields are copied shallowly.
+func (o *Backend) DeepCopy() *Backend {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	out.LoadBalancer = *out.LoadBalancer.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Backend object is equal to the given one, comparing all fields deeply.
+func (o *Backend) Equal(other *Backend) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.HealthCheck != other.HealthCheck {
+		return false
+	}
+	if o.Mode != other.Mode {
+		return false
+	}
+	if o.ServerTimeout != other.ServerTimeout {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+	if !o.LoadBalancer.Equal(&other.LoadBalancer) {
+		return false
+	}
+
+	return true
+}
+
+// BackendFields contains the names of the fields of Backend objects, usable with compare.Compare and compare.Reconcile.
+var BackendFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	HealthCheck        string
+	Mode               string
+	ServerTimeout      string
+	AutomationRules    string
+	LoadBalancer       string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	HealthCheck:        "HealthCheck",
+	Mode:               "Mode",
+	ServerTimeout:      "ServerTimeout",
+	AutomationRules:    "AutomationRules",
+	LoadBalancer:       "LoadBalancer",
+}
+
+// DeepCopy returns a deep copy of the Bind object. Values stored in interface fields are copied shallowly.
+func (o *Bind) DeepCopy() *Bind {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	out.Frontend = *out.Frontend.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Bind object is equal to the given one, comparing all fields deeply.
+func (o *Bind) Equal(other *Bind) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Address != other.Address {
+		return false
+	}
+	if o.Port != other.Port {
+		return false
+	}
+	if o.SSL != other.SSL {
+		return false
+	}
+	if o.SslCertificatePath != other.SslCertificatePath {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+	if !o.Frontend.Equal(&other.Frontend) {
+		return false
+	}
+
+	return true
+}
+
+// BindFields contains the names of the fields of Bind objects, usable with compare.Compare and compare.Reconcile.
+var BindFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	Address            string
+	Port               string
+	SSL                string
+	SslCertificatePath string
+	AutomationRules    string
+	Frontend           string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	Address:            "Address",
+	Port:               "Port",
+	SSL:                "SSL",
+	SslCertificatePath: "SslCertificatePath",
+	AutomationRules:    "AutomationRules",
+	Frontend:           "Frontend",
+}
+
+// DeepCopy returns a deep copy of the Frontend object. Values stored in interface fields are copied shallowly.
+func (o *Frontend) DeepCopy() *Frontend {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	out.LoadBalancer = out.LoadBalancer.DeepCopy()
+	out.DefaultBackend = out.DefaultBackend.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Frontend object is equal to the given one, comparing all fields deeply.
+func (o *Frontend) Equal(other *Frontend) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Mode != other.Mode {
+		return false
+	}
+	if o.ClientTimeout != other.ClientTimeout {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+	if !o.LoadBalancer.Equal(other.LoadBalancer) {
+		return false
+	}
+	if !o.DefaultBackend.Equal(other.DefaultBackend) {
+		return false
+	}
+
+	return true
+}
+
+// FrontendFields contains the names of the fields of Frontend objects, usable with compare.Compare and compare.Reconcile.
+var FrontendFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	Mode               string
+	ClientTimeout      string
+	AutomationRules    string
+	LoadBalancer       string
+	DefaultBackend     string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	Mode:               "Mode",
+	ClientTimeout:      "ClientTimeout",
+	AutomationRules:    "AutomationRules",
+	LoadBalancer:       "LoadBalancer",
+	DefaultBackend:     "DefaultBackend",
+}
+
+// DeepCopy returns a deep copy of the LoadBalancer object. Values stored in interface fields are copied shallowly.
+func (o *LoadBalancer) DeepCopy() *LoadBalancer {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+
+	return &out
+}
+
+// Equal returns if the LoadBalancer object is equal to the given one, comparing all fields deeply.
+func (o *LoadBalancer) Equal(other *LoadBalancer) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.State.State.ID != other.State.State.ID {
+		return false
+	}
+	if o.State.State.Text != other.State.State.Text {
+		return false
+	}
+	if o.State.State.Type != other.State.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.IpAddress != other.IpAddress {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LoadBalancerFields contains the names of the fields of LoadBalancer objects, usable with compare.Compare and compare.Reconcile.
+var LoadBalancerFields = struct {
+	GenericService     string
+	State              string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	IpAddress          string
+	AutomationRules    string
+}{
+	GenericService:     "GenericService",
+	State:              "State",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	IpAddress:          "IpAddress",
+	AutomationRules:    "AutomationRules",
+}
+
+// DeepCopy returns a deep copy of the Rule object. Values stored in interface fields are copied shallowly.
+func (o *Rule) DeepCopy() *Rule {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Index != nil {
+		v1 := *out.Index
+		out.Index = &v1
+	}
+	out.Frontend = *out.Frontend.DeepCopy()
+	out.Backend = *out.Backend.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Rule object is equal to the given one, comparing all fields deeply.
+func (o *Rule) Equal(other *Rule) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.ParentType != other.ParentType {
+		return false
+	}
+	if o.Condition != other.Condition {
+		return false
+	}
+	if o.ConditionTest != other.ConditionTest {
+		return false
+	}
+	if o.Type != other.Type {
+		return false
+	}
+	if o.Action != other.Action {
+		return false
+	}
+	if o.RedirectionType != other.RedirectionType {
+		return false
+	}
+	if o.RedirectionValue != other.RedirectionValue {
+		return false
+	}
+	if o.RedirectionCode != other.RedirectionCode {
+		return false
+	}
+	if o.RuleType != other.RuleType {
+		return false
+	}
+	if (o.Index == nil) != (other.Index == nil) {
+		return false
+	}
+	if o.Index != nil {
+		if (*o.Index) != (*other.Index) {
+			return false
+		}
+	}
+	if !o.Frontend.Equal(&other.Frontend) {
+		return false
+	}
+	if !o.Backend.Equal(&other.Backend) {
+		return false
+	}
+
+	return true
+}
+
+// RuleFields contains the names of the fields of Rule objects, usable with compare.Compare and compare.Reconcile.
+var RuleFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	ParentType         string
+	Condition          string
+	ConditionTest      string
+	Type               string
+	Action             string
+	RedirectionType    string
+	RedirectionValue   string
+	RedirectionCode    string
+	RuleType           string
+	Index              string
+	Frontend           string
+	Backend            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	ParentType:         "ParentType",
+	Condition:          "Condition",
+	ConditionTest:      "ConditionTest",
+	Type:               "Type",
+	Action:             "Action",
+	RedirectionType:    "RedirectionType",
+	RedirectionValue:   "RedirectionValue",
+	RedirectionCode:    "RedirectionCode",
+	RuleType:           "RuleType",
+	Index:              "Index",
+	Frontend:           "Frontend",
+	Backend:            "Backend",
+}
+
+// DeepCopy returns a deep copy of the Server object. Values stored in interface fields are copied shallowly.
+func (o *Server) DeepCopy() *Server {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	out.Backend = *out.Backend.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Server object is equal to the given one, comparing all fields deeply.
+func (o *Server) Equal(other *Server) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.IP != other.IP {
+		return false
+	}
+	if o.Port != other.Port {
+		return false
+	}
+	if o.Check != other.Check {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+	}
+	if !o.Backend.Equal(&other.Backend) {
+		return false
+	}
+
+	return true
+}
+
+// ServerFields contains the names of the fields of Server objects, usable with compare.Compare and compare.Reconcile.
+var ServerFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Name               string
+	IP                 string
+	Port               string
+	Check              string
+	AutomationRules    string
+	Backend            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Name:               "Name",
+	IP:                 "IP",
+	Port:               "Port",
+	Check:              "Check",
+	AutomationRules:    "AutomationRules",
+	Backend:            "Backend",
+}
@@ -24,6 +24,9 @@ var _ = Describe("Object ACL", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ACLFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Backend", func() {
@@ -40,6 +43,9 @@ var _ = Describe("Object Backend", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.BackendFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Bind", func() {
@@ -56,6 +62,9 @@ var _ = Describe("Object Bind", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.BindFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Frontend", func() {
@@ -72,6 +81,9 @@ var _ = Describe("Object Frontend", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.FrontendFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object LoadBalancer", func() {
@@ -92,6 +104,9 @@ var _ = Describe("Object LoadBalancer", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.LoadBalancerFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Rule", func() {
@@ -108,6 +123,9 @@ var _ = Describe("Object Rule", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.RuleFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Server", func() {
@@ -124,4 +142,7 @@ var _ = Describe("Object Server", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ServerFields)
+	testutils.ContractTests(&o)
 })
@@ -4,6 +4,7 @@ package v2
 
 import (
 	"context"
+	"slices"
 )
 
 // GetIdentifier returns the primary identifier of a Cluster object
@@ -20,3 +21,381 @@ func (o *Node) GetIdentifier(ctx context.Context) (string, error) {
 func (o *LoadBalancer) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the Cluster object. Values stored in interface fields are copied shallowly.
+func (o *Cluster) DeepCopy() *Cluster {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.FrontendPrefixes != nil {
+		v1 := *out.FrontendPrefixes
+		v1 = slices.Clone(v1)
+		out.FrontendPrefixes = &v1
+	}
+	if out.BackendPrefixes != nil {
+		v1 := *out.BackendPrefixes
+		v1 = slices.Clone(v1)
+		out.BackendPrefixes = &v1
+	}
+	if out.Replicas != nil {
+		v1 := *out.Replicas
+		out.Replicas = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Cluster object is equal to the given one, comparing all fields deeply.
+func (o *Cluster) Equal(other *Cluster) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Implementation != other.Implementation {
+		return false
+	}
+	if (o.FrontendPrefixes == nil) != (other.FrontendPrefixes == nil) {
+		return false
+	}
+	if o.FrontendPrefixes != nil {
+		if ((*o.FrontendPrefixes) == nil) != ((*other.FrontendPrefixes) == nil) || len((*o.FrontendPrefixes)) != len((*other.FrontendPrefixes)) {
+			return false
+		}
+		for i2 := range *o.FrontendPrefixes {
+			if (*o.FrontendPrefixes)[i2].Identifier != (*other.FrontendPrefixes)[i2].Identifier {
+				return false
+			}
+			if (*o.FrontendPrefixes)[i2].Name != (*other.FrontendPrefixes)[i2].Name {
+				return false
+			}
+		}
+	}
+	if (o.BackendPrefixes == nil) != (other.BackendPrefixes == nil) {
+		return false
+	}
+	if o.BackendPrefixes != nil {
+		if ((*o.BackendPrefixes) == nil) != ((*other.BackendPrefixes) == nil) || len((*o.BackendPrefixes)) != len((*other.BackendPrefixes)) {
+			return false
+		}
+		for i2 := range *o.BackendPrefixes {
+			if (*o.BackendPrefixes)[i2].Identifier != (*other.BackendPrefixes)[i2].Identifier {
+				return false
+			}
+			if (*o.BackendPrefixes)[i2].Name != (*other.BackendPrefixes)[i2].Name {
+				return false
+			}
+		}
+	}
+	if (o.Replicas == nil) != (other.Replicas == nil) {
+		return false
+	}
+	if o.Replicas != nil {
+		if (*o.Replicas) != (*other.Replicas) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ClusterFields contains the names of the fields of Cluster objects, usable with compare.Compare and compare.Reconcile.
+var ClusterFields = struct {
+	GenericService   string
+	HasState         string
+	Identifier       string
+	Name             string
+	Implementation   string
+	FrontendPrefixes string
+	BackendPrefixes  string
+	Replicas         string
+}{
+	GenericService:   "GenericService",
+	HasState:         "HasState",
+	Identifier:       "Identifier",
+	Name:             "Name",
+	Implementation:   "Implementation",
+	FrontendPrefixes: "FrontendPrefixes",
+	BackendPrefixes:  "BackendPrefixes",
+	Replicas:         "Replicas",
+}
+
+// DeepCopy returns a deep copy of the Node object. Values stored in interface fields are copied shallowly.
+func (o *Node) DeepCopy() *Node {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Cluster != nil {
+		v1 := *out.Cluster
+		out.Cluster = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Node object is equal to the given one, comparing all fields deeply.
+func (o *Node) Equal(other *Node) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.Cluster == nil) != (other.Cluster == nil) {
+		return false
+	}
+	if o.Cluster != nil {
+		if (*o.Cluster).Identifier != (*other.Cluster).Identifier {
+			return false
+		}
+		if (*o.Cluster).Name != (*other.Cluster).Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NodeFields contains the names of the fields of Node objects, usable with compare.Compare and compare.Reconcile.
+var NodeFields = struct {
+	GenericService string
+	HasState       string
+	Identifier     string
+	Name           string
+	Cluster        string
+}{
+	GenericService: "GenericService",
+	HasState:       "HasState",
+	Identifier:     "Identifier",
+	Name:           "Name",
+	Cluster:        "Cluster",
+}
+
+// DeepCopy returns a deep copy of the LoadBalancer object. Values stored in interface fields are copied shallowly.
+func (o *LoadBalancer) DeepCopy() *LoadBalancer {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	if out.Cluster != nil {
+		v1 := *out.Cluster
+		out.Cluster = &v1
+	}
+	if out.FrontendIPs != nil {
+		v1 := *out.FrontendIPs
+		v1 = slices.Clone(v1)
+		out.FrontendIPs = &v1
+	}
+	if out.SSLCertificates != nil {
+		v1 := *out.SSLCertificates
+		v1 = slices.Clone(v1)
+		out.SSLCertificates = &v1
+	}
+	if out.Definition != nil {
+		v1 := *out.Definition
+		v1.Frontends = slices.Clone(v1.Frontends)
+		for i3 := range v1.Frontends {
+			if v1.Frontends[i3].Backend.TCP != nil {
+				v6 := *v1.Frontends[i3].Backend.TCP
+				v1.Frontends[i3].Backend.TCP = &v6
+			}
+			if v1.Frontends[i3].TCP != nil {
+				v5 := *v1.Frontends[i3].TCP
+				v1.Frontends[i3].TCP = &v5
+			}
+		}
+		v1.Backends = slices.Clone(v1.Backends)
+		for i3 := range v1.Backends {
+			v1.Backends[i3].IPs = slices.Clone(v1.Backends[i3].IPs)
+			v1.Backends[i3].Frontends = slices.Clone(v1.Backends[i3].Frontends)
+		}
+		out.Definition = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the LoadBalancer object is equal to the given one, comparing all fields deeply.
+func (o *LoadBalancer) Equal(other *LoadBalancer) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Generation != other.Generation {
+		return false
+	}
+	if (o.Cluster == nil) != (other.Cluster == nil) {
+		return false
+	}
+	if o.Cluster != nil {
+		if (*o.Cluster).Identifier != (*other.Cluster).Identifier {
+			return false
+		}
+		if (*o.Cluster).Name != (*other.Cluster).Name {
+			return false
+		}
+	}
+	if (o.FrontendIPs == nil) != (other.FrontendIPs == nil) {
+		return false
+	}
+	if o.FrontendIPs != nil {
+		if ((*o.FrontendIPs) == nil) != ((*other.FrontendIPs) == nil) || len((*o.FrontendIPs)) != len((*other.FrontendIPs)) {
+			return false
+		}
+		for i2 := range *o.FrontendIPs {
+			if (*o.FrontendIPs)[i2].Identifier != (*other.FrontendIPs)[i2].Identifier {
+				return false
+			}
+			if (*o.FrontendIPs)[i2].Name != (*other.FrontendIPs)[i2].Name {
+				return false
+			}
+		}
+	}
+	if (o.SSLCertificates == nil) != (other.SSLCertificates == nil) {
+		return false
+	}
+	if o.SSLCertificates != nil {
+		if ((*o.SSLCertificates) == nil) != ((*other.SSLCertificates) == nil) || len((*o.SSLCertificates)) != len((*other.SSLCertificates)) {
+			return false
+		}
+		for i2 := range *o.SSLCertificates {
+			if (*o.SSLCertificates)[i2].Identifier != (*other.SSLCertificates)[i2].Identifier {
+				return false
+			}
+			if (*o.SSLCertificates)[i2].Name != (*other.SSLCertificates)[i2].Name {
+				return false
+			}
+		}
+	}
+	if (o.Definition == nil) != (other.Definition == nil) {
+		return false
+	}
+	if o.Definition != nil {
+		if ((*o.Definition).Frontends == nil) != ((*other.Definition).Frontends == nil) || len((*o.Definition).Frontends) != len((*other.Definition).Frontends) {
+			return false
+		}
+		for i3 := range (*o.Definition).Frontends {
+			if (*o.Definition).Frontends[i3].Name != (*other.Definition).Frontends[i3].Name {
+				return false
+			}
+			if (*o.Definition).Frontends[i3].Protocol != (*other.Definition).Frontends[i3].Protocol {
+				return false
+			}
+			if (*o.Definition).Frontends[i3].Backend.Protocol != (*other.Definition).Frontends[i3].Backend.Protocol {
+				return false
+			}
+			if ((*o.Definition).Frontends[i3].Backend.TCP == nil) != ((*other.Definition).Frontends[i3].Backend.TCP == nil) {
+				return false
+			}
+			if (*o.Definition).Frontends[i3].Backend.TCP != nil {
+				if (*(*o.Definition).Frontends[i3].Backend.TCP).Port != (*(*other.Definition).Frontends[i3].Backend.TCP).Port {
+					return false
+				}
+			}
+			if ((*o.Definition).Frontends[i3].TCP == nil) != ((*other.Definition).Frontends[i3].TCP == nil) {
+				return false
+			}
+			if (*o.Definition).Frontends[i3].TCP != nil {
+				if (*(*o.Definition).Frontends[i3].TCP).Port != (*(*other.Definition).Frontends[i3].TCP).Port {
+					return false
+				}
+			}
+		}
+		if ((*o.Definition).Backends == nil) != ((*other.Definition).Backends == nil) || len((*o.Definition).Backends) != len((*other.Definition).Backends) {
+			return false
+		}
+		for i3 := range (*o.Definition).Backends {
+			if (*o.Definition).Backends[i3].Name != (*other.Definition).Backends[i3].Name {
+				return false
+			}
+			if ((*o.Definition).Backends[i3].IPs == nil) != ((*other.Definition).Backends[i3].IPs == nil) || len((*o.Definition).Backends[i3].IPs) != len((*other.Definition).Backends[i3].IPs) {
+				return false
+			}
+			for i5 := range (*o.Definition).Backends[i3].IPs {
+				if (*o.Definition).Backends[i3].IPs[i5] != (*other.Definition).Backends[i3].IPs[i5] {
+					return false
+				}
+			}
+			if ((*o.Definition).Backends[i3].Frontends == nil) != ((*other.Definition).Backends[i3].Frontends == nil) || len((*o.Definition).Backends[i3].Frontends) != len((*other.Definition).Backends[i3].Frontends) {
+				return false
+			}
+			for i5 := range (*o.Definition).Backends[i3].Frontends {
+				if (*o.Definition).Backends[i3].Frontends[i5].Name != (*other.Definition).Backends[i3].Frontends[i5].Name {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// LoadBalancerFields contains the names of the fields of LoadBalancer objects, usable with compare.Compare and compare.Reconcile.
+var LoadBalancerFields = struct {
+	GenericService  string
+	HasState        string
+	Identifier      string
+	Name            string
+	Generation      string
+	Cluster         string
+	FrontendIPs     string
+	SSLCertificates string
+	Definition      string
+}{
+	GenericService:  "GenericService",
+	HasState:        "HasState",
+	Identifier:      "Identifier",
+	Name:            "Name",
+	Generation:      "Generation",
+	Cluster:         "Cluster",
+	FrontendIPs:     "FrontendIPs",
+	SSLCertificates: "SSLCertificates",
+	Definition:      "Definition",
+}
@@ -20,6 +20,9 @@ var _ = Describe("Object Cluster", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.ClusterFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Node", func() {
@@ -32,6 +35,9 @@ var _ = Describe("Object Node", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.NodeFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object LoadBalancer", func() {
@@ -44,4 +50,7 @@ var _ = Describe("Object LoadBalancer", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.LoadBalancerFields)
+	testutils.ContractTests(&o)
 })
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+var errAutomationResourceRequired = fmt.Errorf("%w: resource type and identifier are required", api.ErrOperationNotSupported)
+
+func automationProcessURL(resourceType AutomationResourceType, resourceIdentifier string) (*url.URL, error) {
+	if resourceType == "" || resourceIdentifier == "" {
+		return nil, errAutomationResourceRequired
+	}
+
+	return url.Parse(fmt.Sprintf("/api/object_storage/v2/%s/%s/automation-process", resourceType, url.PathEscape(resourceIdentifier)))
+}
+
+// EndpointURL returns the URL where to retrieve the automation rule processes of the configured resource.
+// Only List operations are supported.
+func (e *AutomationRuleExecution) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationList {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	return automationProcessURL(e.ResourceType, e.ResourceIdentifier)
+}
+
+// HasPagination returns false, as the automation rule processes are returned in a single response.
+func (e *AutomationRuleExecution) HasPagination(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+// FilterAPIResponse unwraps the list of automation rule processes from the response object.
+func (e *AutomationRuleExecution) FilterAPIResponse(ctx context.Context, res *http.Response) (*http.Response, error) {
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	var response struct {
+		AutomationRules []json.RawMessage `json:"automation_rules"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode automation rule processes: %w", err)
+	}
+
+	if response.AutomationRules == nil {
+		response.AutomationRules = []json.RawMessage{}
+	}
+
+	data, err := json.Marshal(response.AutomationRules)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	return res, nil
+}
+
+// EndpointURL returns the URL where to retrieve automation rule processes of the configured resource.
+// Only Get operations are supported.
+func (p *AutomationRuleProcess) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationGet {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	return automationProcessURL(p.ResourceType, p.ResourceReference.Identifier)
+}
@@ -0,0 +1,134 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+	"go.anx.io/go-anxcloud/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Automation rule processes", func() {
+	var (
+		a   api.API
+		srv *ghttp.Server
+	)
+
+	const processesPath = "/api/object_storage/v2/bucket/bucket-id/automation-process"
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("lists the processes of a resource", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", processesPath),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"automation_rules": []map[string]interface{}{
+					{"identifier": "rule-id", "name": "Empty and delete the bucket", "automation_rule_process_identifier": "process-id"},
+				},
+			}),
+		))
+
+		executions, err := objectstoragev2.ListAutomationRuleProcesses(context.TODO(), a, &objectstoragev2.Bucket{Identifier: "bucket-id"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(executions).To(ConsistOf(objectstoragev2.AutomationRuleExecution{
+			ProcessIdentifier:  "process-id",
+			RuleIdentifier:     "rule-id",
+			Name:               "Empty and delete the bucket",
+			ResourceType:       objectstoragev2.AutomationResourceBucket,
+			ResourceIdentifier: "bucket-id",
+		}))
+	})
+
+	It("rejects objects not supporting automation rules", func() {
+		_, err := objectstoragev2.ListAutomationRuleProcesses(context.TODO(), a, &objectstoragev2.Key{Identifier: "key-id"})
+		Expect(err).To(MatchError(objectstoragev2.ErrAutomationNotSupported))
+	})
+
+	It("waits for the process to succeed and reports progress", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", processesPath+"/process-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier": "process-id",
+					"status":     map[string]interface{}{"status_code": 2, "status_type": "Running"},
+					"progress":   50,
+				}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", processesPath+"/process-id"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier": "process-id",
+					"status":     map[string]interface{}{"status_code": 1, "status_type": "Success"},
+					"progress":   100,
+				}),
+			),
+		)
+
+		progress := make([]int, 0, 2)
+		process, err := objectstoragev2.AwaitAutomationRuleProcess(context.TODO(), a, &objectstoragev2.Bucket{Identifier: "bucket-id"}, "process-id", time.Millisecond,
+			func(p objectstoragev2.AutomationRuleProcess) {
+				progress = append(progress, p.Progress)
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(process.Status.IsSuccess()).To(BeTrue())
+		Expect(progress).To(Equal([]int{50, 100}))
+	})
+
+	It("returns the failed tasks of failed processes", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", processesPath+"/process-id"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"identifier": "process-id",
+				"rule":       map[string]interface{}{"name": "Empty and delete the bucket"},
+				"status":     map[string]interface{}{"status_code": 3, "status_type": "Failed"},
+				"process_tasks": []map[string]interface{}{
+					{"name": "Empty bucket", "status": map[string]interface{}{"status_type": "Success"}},
+					{
+						"name":      "Delete bucket",
+						"status":    map[string]interface{}{"status_type": "Failed"},
+						"task_info": map[string]interface{}{"error": "bucket not empty"},
+					},
+				},
+			}),
+		))
+
+		_, err := objectstoragev2.AwaitAutomationRuleProcess(context.TODO(), a, &objectstoragev2.Bucket{Identifier: "bucket-id"}, "process-id", time.Millisecond, nil)
+		Expect(err).To(MatchError(objectstoragev2.ErrAutomationRuleProcessFailed))
+
+		var processError *objectstoragev2.AutomationRuleProcessError
+		Expect(errors.As(err, &processError)).To(BeTrue())
+		Expect(processError.FailedTasks).To(HaveLen(1))
+		Expect(processError.FailedTasks[0].Name).To(Equal("Delete bucket"))
+		Expect(err.Error()).To(Equal(`automation rule process "Empty and delete the bucket" finished with status "Failed", failed tasks: Delete bucket (Failed): bucket not empty`))
+	})
+
+	It("returns the process identifier when triggering empty and delete", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", "/api/object_storage/v2/bucket/bucket-id/trigger/empty_and_delete"),
+			ghttp.VerifyJSON(`{"empty_and_delete":true}`),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"automation_rule_process_identifier": "process-id"}),
+		))
+
+		processID, err := objectstoragev2.TriggerEmptyAndDelete(context.TODO(), a, "bucket-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(processID).To(Equal("process-id"))
+	})
+})
@@ -0,0 +1,289 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/apis/common"
+)
+
+// AutomationResourceType is the type of an Object Storage resource supporting automation rules, used as
+// path segment in the automation rule process endpoints.
+type AutomationResourceType string
+
+// Object Storage resource types supporting automation rules.
+const (
+	AutomationResourceS3Backend AutomationResourceType = "s3_backend"
+	AutomationResourceTenant    AutomationResourceType = "tenant"
+	AutomationResourceBucket    AutomationResourceType = "bucket"
+	AutomationResourceUser      AutomationResourceType = "user"
+)
+
+// ErrAutomationNotSupported is returned when trying to track automation rule processes of an object not supporting them.
+var ErrAutomationNotSupported = errors.New("object does not support automation rules")
+
+// defaultAutomationRuleProcessPollInterval is the interval in which automation rule processes are retrieved while
+// waiting for them.
+const defaultAutomationRuleProcessPollInterval = 5 * time.Second
+
+// AutomationResourceTypeOf returns the AutomationResourceType for the given Object Storage object.
+func AutomationResourceTypeOf(obj types.Object) (AutomationResourceType, error) {
+	switch obj.(type) {
+	case *S3Backend:
+		return AutomationResourceS3Backend, nil
+	case *Tenant:
+		return AutomationResourceTenant, nil
+	case *Bucket:
+		return AutomationResourceBucket, nil
+	case *User:
+		return AutomationResourceUser, nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrAutomationNotSupported, reflect.TypeOf(obj))
+	}
+}
+
+// anxcloud:object:hooks=ResponseFilterHook,PaginationSupportHook:nocontract
+
+// AutomationRuleExecution is a pending or running automation rule process of a resource. It can only be listed,
+// ResourceType and ResourceIdentifier have to be set to select the resource to list the processes of.
+type AutomationRuleExecution struct {
+	ProcessIdentifier string `json:"automation_rule_process_identifier,omitempty" anxcloud:"identifier"`
+	RuleIdentifier    string `json:"identifier,omitempty"`
+	Name              string `json:"name,omitempty"`
+
+	ResourceType       AutomationResourceType `json:"-"`
+	ResourceIdentifier string                 `json:"-"`
+}
+
+// AutomationRuleProcessError is returned when an automation rule process failed. It contains the failed tasks
+// of the process to give details about the failure.
+type AutomationRuleProcessError struct {
+	// Process is the failed process as last retrieved from the Engine.
+	Process AutomationRuleProcess
+
+	// FailedTasks contains all tasks of the process not finished successfully.
+	FailedTasks []AutomationRuleProcessTask
+}
+
+// Error returns the error message, including the names and messages of the failed tasks.
+func (e *AutomationRuleProcessError) Error() string {
+	var sb strings.Builder
+
+	name := e.Process.Rule.Name
+	if name == "" {
+		name = e.Process.Identifier
+	}
+
+	fmt.Fprintf(&sb, "automation rule process %q finished with status %q", name, e.Process.Status.StatusType)
+
+	if e.Process.Message != nil && *e.Process.Message != "" {
+		fmt.Fprintf(&sb, ": %s", *e.Process.Message)
+	}
+
+	for i, task := range e.FailedTasks {
+		if i == 0 {
+			sb.WriteString(", failed tasks: ")
+		} else {
+			sb.WriteString("; ")
+		}
+
+		fmt.Fprintf(&sb, "%s (%s)", task.Name, task.Status.StatusType)
+
+		if msg := task.message(); msg != "" {
+			fmt.Fprintf(&sb, ": %s", msg)
+		}
+	}
+
+	return sb.String()
+}
+
+// Is makes the error compatible with errors.Is(err, ErrAutomationRuleProcessFailed).
+func (e *AutomationRuleProcessError) Is(target error) bool {
+	return target == ErrAutomationRuleProcessFailed
+}
+
+// ErrAutomationRuleProcessFailed can be used with errors.Is to check if an automation rule process failed,
+// use errors.As with *AutomationRuleProcessError to retrieve details.
+var ErrAutomationRuleProcessFailed = errors.New("automation rule process failed")
+
+func (t AutomationRuleProcessTask) message() string {
+	if t.TaskInfo == nil {
+		return ""
+	}
+
+	if t.TaskInfo.Error != nil && *t.TaskInfo.Error != "" {
+		return *t.TaskInfo.Error
+	}
+
+	if t.TaskInfo.StatusText != nil {
+		return *t.TaskInfo.StatusText
+	}
+
+	return ""
+}
+
+// ListAutomationRuleProcesses retrieves the pending and running automation rule processes of the given
+// Object Storage object, which has to be identified.
+func ListAutomationRuleProcesses(ctx context.Context, a api.API, obj types.IdentifiedObject) ([]AutomationRuleExecution, error) {
+	resourceType, identifier, err := automationResource(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var channel types.ObjectChannel
+	filter := AutomationRuleExecution{ResourceType: resourceType, ResourceIdentifier: identifier}
+	if err := a.List(ctx, &filter, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed to list automation rule processes: %w", err)
+	}
+
+	executions := make([]AutomationRuleExecution, 0)
+	for retriever := range channel {
+		var execution AutomationRuleExecution
+		if err := retriever(&execution); err != nil {
+			return nil, fmt.Errorf("failed to retrieve automation rule process: %w", err)
+		}
+
+		execution.ResourceType = resourceType
+		execution.ResourceIdentifier = identifier
+		executions = append(executions, execution)
+	}
+
+	return executions, nil
+}
+
+// GetAutomationRuleProcess retrieves the automation rule process identified by processID of the given
+// Object Storage object, which has to be identified.
+func GetAutomationRuleProcess(ctx context.Context, a api.API, obj types.IdentifiedObject, processID string) (*AutomationRuleProcess, error) {
+	resourceType, identifier, err := automationResource(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	process := newAutomationRuleProcess(resourceType, identifier, processID)
+	if err := a.Get(ctx, process); err != nil {
+		return nil, fmt.Errorf("failed to get automation rule process: %w", err)
+	}
+
+	return process, nil
+}
+
+// AutomationRuleProcessProgressFunc is called with the current state of an automation rule process while
+// waiting for it, every time its progress, status or tasks changed.
+type AutomationRuleProcessProgressFunc func(AutomationRuleProcess)
+
+// AwaitAutomationRuleProcess polls the automation rule process identified by processID of the given Object
+// Storage object in the given interval (5 seconds if zero) until its status is terminal. The optional onProgress
+// callback is called every time the process changed. If the process failed, an *AutomationRuleProcessError is
+// returned.
+func AwaitAutomationRuleProcess(ctx context.Context, a api.API, obj types.IdentifiedObject, processID string, interval time.Duration, onProgress AutomationRuleProcessProgressFunc) (*AutomationRuleProcess, error) {
+	resourceType, identifier, err := automationResource(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = defaultAutomationRuleProcessPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var previous *AutomationRuleProcess
+
+	for {
+		process := newAutomationRuleProcess(resourceType, identifier, processID)
+		if err := a.Get(ctx, process); err != nil {
+			return nil, fmt.Errorf("failed to get automation rule process: %w", err)
+		}
+
+		if onProgress != nil && (previous == nil || !reflect.DeepEqual(*previous, *process)) {
+			onProgress(*process)
+		}
+		previous = process
+
+		if process.Status.IsFailed() {
+			return process, newAutomationRuleProcessError(*process)
+		} else if process.Status.IsSuccess() {
+			return process, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return process, ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
+
+// AwaitAutomationRuleProcesses waits for all pending and running automation rule processes of the given
+// Object Storage object, polling them in the given interval (5 seconds if zero). Errors of all failed processes
+// are joined.
+func AwaitAutomationRuleProcesses(ctx context.Context, a api.API, obj types.IdentifiedObject, interval time.Duration, onProgress AutomationRuleProcessProgressFunc) error {
+	executions, err := ListAutomationRuleProcesses(ctx, a, obj)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, execution := range executions {
+		if execution.ProcessIdentifier == "" {
+			continue
+		}
+
+		if _, err := AwaitAutomationRuleProcess(ctx, a, obj, execution.ProcessIdentifier, interval, onProgress); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func newAutomationRuleProcessError(process AutomationRuleProcess) error {
+	failedTasks := make([]AutomationRuleProcessTask, 0)
+	for _, task := range process.ProcessTasks {
+		if task.Status.IsFailed() || (task.Status.StatusType != "" && !task.Status.IsTerminal()) {
+			failedTasks = append(failedTasks, task)
+		}
+	}
+
+	return &AutomationRuleProcessError{
+		Process:     process,
+		FailedTasks: failedTasks,
+	}
+}
+
+func newAutomationRuleProcess(resourceType AutomationResourceType, resourceIdentifier, processID string) *AutomationRuleProcess {
+	return &AutomationRuleProcess{
+		Identifier:        processID,
+		ResourceType:      resourceType,
+		ResourceReference: common.PartialResource{Identifier: resourceIdentifier},
+	}
+}
+
+func automationResource(obj types.IdentifiedObject) (AutomationResourceType, string, error) {
+	resourceType, err := AutomationResourceTypeOf(obj)
+	if err != nil {
+		return "", "", err
+	}
+
+	identifier, err := types.GetObjectIdentifier(obj, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	return resourceType, identifier, nil
+}
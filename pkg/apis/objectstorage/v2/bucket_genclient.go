@@ -26,7 +26,7 @@ func (b *Bucket) EndpointURL(ctx context.Context) (*url.URL, error) {
 		query := u.Query()
 
 		// Add attributes parameter to get all fields
-		query.Add("attributes", "name,state,region,object_count,object_size,backend,tenant,reseller,customer")
+		query.Add("attributes", "name,state,region,object_count,object_size,versioning_active,object_lock_lifetime,description,backend,tenant,reseller,customer")
 
 		// Add embed parameter if specified
 		if len(b.Embed) > 0 {
@@ -77,6 +77,12 @@ func (b *Bucket) FilterAPIRequestBody(ctx context.Context) (interface{}, error)
 		return nil, err
 	}
 
+	if op == types.OperationCreate {
+		if err := validateBucketCreateSettings(b.Settings()); err != nil {
+			return nil, err
+		}
+	}
+
 	body := requestBody(ctx, func() interface{} {
 		// For UPDATE operations, only send the fields that are actually being updated
 		if op == types.OperationUpdate {
@@ -89,6 +95,9 @@ func (b *Bucket) FilterAPIRequestBody(ctx context.Context) (interface{}, error)
 			if b.ObjectLockLifetime != nil {
 				updateBody["object_lock_lifetime"] = *b.ObjectLockLifetime
 			}
+			if b.Description != "" {
+				updateBody["description"] = b.Description
+			}
 
 			return updateBody
 		}
@@ -102,12 +111,16 @@ func (b *Bucket) FilterAPIRequestBody(ctx context.Context) (interface{}, error)
 			CustomerIdentifier string  `json:"customer_identifier"`           // Always include (can be empty)
 			ResellerIdentifier *string `json:"reseller_identifier,omitempty"` // Omit if empty
 			Share              *bool   `json:"share,omitempty"`               // Omit if false
+			Description        string  `json:"description,omitempty"`
+			VersioningActive   *bool   `json:"versioning_active,omitempty"`    // Omit if false
+			ObjectLockLifetime *int    `json:"object_lock_lifetime,omitempty"` // Omit if disabled
 		}{
 			Name:               b.Name,
 			Region:             b.Region.Identifier,
 			Backend:            b.Backend.Identifier,
 			Tenant:             b.Tenant.Identifier,
 			CustomerIdentifier: b.CustomerIdentifier, // Always include (even if empty)
+			Description:        b.Description,
 		}
 
 		// Conditionally include optional fields only if they have non-default values
@@ -119,6 +132,14 @@ func (b *Bucket) FilterAPIRequestBody(ctx context.Context) (interface{}, error)
 			reqBody.Share = &b.Share
 		}
 
+		if b.VersioningActive {
+			reqBody.VersioningActive = &b.VersioningActive
+		}
+
+		if b.ObjectLockLifetime != nil && *b.ObjectLockLifetime > 0 {
+			reqBody.ObjectLockLifetime = b.ObjectLockLifetime
+		}
+
 		return reqBody
 	})
 	return body, nil
@@ -138,3 +159,8 @@ func (b *Bucket) FilterAPIRequest(ctx context.Context, req *http.Request) (*http
 
 	return req, nil
 }
+
+// SupportsPatch returns true as the Object Storage API accepts PATCH requests containing only the changed fields.
+func (b *Bucket) SupportsPatch(ctx context.Context) bool {
+	return true
+}
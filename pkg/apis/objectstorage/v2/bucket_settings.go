@@ -0,0 +1,213 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
+)
+
+// MaxObjectLockLifetime is the maximum object lock lifetime in days supported by the Engine.
+const MaxObjectLockLifetime = 7
+
+var (
+	// ErrObjectLockLifetimeOutOfRange is returned when the object lock lifetime is negative or higher than MaxObjectLockLifetime.
+	ErrObjectLockLifetimeOutOfRange = fmt.Errorf("object lock lifetime must be between 0 and %d days", MaxObjectLockLifetime)
+
+	// ErrObjectLockOnlyOnCreate is returned when trying to enable the object lock on an existing bucket.
+	ErrObjectLockOnlyOnCreate = errors.New("object lock can only be enabled when creating the bucket")
+
+	// ErrObjectLockCannotBeDisabled is returned when trying to disable the object lock of a bucket.
+	ErrObjectLockCannotBeDisabled = errors.New("object lock cannot be disabled once it was enabled")
+
+	// ErrObjectLockRequiresVersioning is returned when the object lock is enabled without versioning being active,
+	// or when trying to suspend versioning on a bucket with object lock enabled.
+	ErrObjectLockRequiresVersioning = errors.New("object lock requires versioning to be active")
+)
+
+// defaultBucketSettingsPollInterval is the interval in which a Bucket is retrieved while waiting for changed settings
+// to be applied.
+const defaultBucketSettingsPollInterval = 5 * time.Second
+
+// BucketSettings contains the versioning and object lock configuration of a Bucket.
+type BucketSettings struct {
+	// VersioningActive configures if object versioning is active for the bucket.
+	VersioningActive bool
+
+	// ObjectLockLifetime is the lifetime of the object lock in days, 0 meaning object lock is disabled.
+	ObjectLockLifetime int
+}
+
+// BucketSettingsOptions configures the helpers changing the settings of a Bucket.
+type BucketSettingsOptions struct {
+	// PollInterval is the interval in which the Bucket is retrieved while waiting for the changed settings to be
+	// applied, 5 seconds if zero.
+	PollInterval time.Duration
+}
+
+// Settings returns the versioning and object lock configuration of the Bucket.
+func (b *Bucket) Settings() BucketSettings {
+	settings := BucketSettings{
+		VersioningActive: b.VersioningActive,
+	}
+
+	if b.ObjectLockLifetime != nil {
+		settings.ObjectLockLifetime = *b.ObjectLockLifetime
+	}
+
+	return settings
+}
+
+// ObjectLockEnabled returns true if the object lock is enabled on the Bucket.
+func (b *Bucket) ObjectLockEnabled() bool {
+	return b.ObjectLockLifetime != nil && *b.ObjectLockLifetime > 0
+}
+
+func validateBucketCreateSettings(settings BucketSettings) error {
+	if settings.ObjectLockLifetime < 0 || settings.ObjectLockLifetime > MaxObjectLockLifetime {
+		return ErrObjectLockLifetimeOutOfRange
+	}
+
+	if settings.ObjectLockLifetime > 0 && !settings.VersioningActive {
+		return ErrObjectLockRequiresVersioning
+	}
+
+	return nil
+}
+
+// ValidateBucketSettingsTransition checks if the settings of an existing Bucket can be changed from current
+// to desired. Object lock can only be enabled on bucket creation, cannot be disabled afterwards and requires
+// versioning to stay active, only its lifetime can be changed.
+func ValidateBucketSettingsTransition(current, desired BucketSettings) error {
+	if desired.ObjectLockLifetime < 0 || desired.ObjectLockLifetime > MaxObjectLockLifetime {
+		return ErrObjectLockLifetimeOutOfRange
+	}
+
+	if current.ObjectLockLifetime == 0 && desired.ObjectLockLifetime > 0 {
+		return ErrObjectLockOnlyOnCreate
+	}
+
+	if current.ObjectLockLifetime > 0 && desired.ObjectLockLifetime == 0 {
+		return ErrObjectLockCannotBeDisabled
+	}
+
+	if desired.ObjectLockLifetime > 0 && !desired.VersioningActive {
+		return ErrObjectLockRequiresVersioning
+	}
+
+	return nil
+}
+
+// UpdateBucketSettings validates and applies the desired versioning and object lock settings to the Bucket
+// identified by bucketID. It waits until the Engine finished applying the settings and returns the updated
+// Bucket. Returns the Bucket unchanged if the desired settings are already active.
+func UpdateBucketSettings(ctx context.Context, a api.API, bucketID string, desired BucketSettings, opts BucketSettingsOptions) (*Bucket, error) {
+	return updateBucketSettingsWith(ctx, a, bucketID, opts, func(s *BucketSettings) {
+		*s = desired
+	})
+}
+
+// EnableVersioning activates object versioning for the Bucket identified by bucketID.
+func EnableVersioning(ctx context.Context, a api.API, bucketID string, opts BucketSettingsOptions) (*Bucket, error) {
+	return updateBucketSettingsWith(ctx, a, bucketID, opts, func(s *BucketSettings) {
+		s.VersioningActive = true
+	})
+}
+
+// SuspendVersioning deactivates object versioning for the Bucket identified by bucketID. Existing object
+// versions are retained. Returns ErrObjectLockRequiresVersioning for buckets with object lock enabled.
+func SuspendVersioning(ctx context.Context, a api.API, bucketID string, opts BucketSettingsOptions) (*Bucket, error) {
+	return updateBucketSettingsWith(ctx, a, bucketID, opts, func(s *BucketSettings) {
+		s.VersioningActive = false
+	})
+}
+
+// SetObjectLockLifetime changes the object lock lifetime of the Bucket identified by bucketID. Object lock
+// has to be enabled when creating the bucket already, this only adjusts the lifetime afterwards.
+func SetObjectLockLifetime(ctx context.Context, a api.API, bucketID string, days int, opts BucketSettingsOptions) (*Bucket, error) {
+	return updateBucketSettingsWith(ctx, a, bucketID, opts, func(s *BucketSettings) {
+		s.ObjectLockLifetime = days
+	})
+}
+
+// updateBucketSettingsWith retrieves the current settings of the bucket, lets mutate change them to the
+// desired ones and applies the difference after validating the transition.
+func updateBucketSettingsWith(ctx context.Context, a api.API, bucketID string, opts BucketSettingsOptions, mutate func(*BucketSettings)) (*Bucket, error) {
+	bucket := Bucket{Identifier: bucketID}
+	if err := a.Get(ctx, &bucket); err != nil {
+		return nil, fmt.Errorf("failed to get bucket: %w", err)
+	}
+
+	current := bucket.Settings()
+	desired := current
+	mutate(&desired)
+
+	if current == desired {
+		return &bucket, nil
+	}
+
+	if err := ValidateBucketSettingsTransition(current, desired); err != nil {
+		return nil, err
+	}
+
+	update := bucketSettings{BucketIdentifier: bucketID}
+
+	if current.VersioningActive != desired.VersioningActive {
+		update.VersioningActive = &desired.VersioningActive
+	}
+
+	if current.ObjectLockLifetime != desired.ObjectLockLifetime {
+		update.ObjectLockLifetime = &desired.ObjectLockLifetime
+	}
+
+	if err := a.Update(ctx, &update); err != nil {
+		return nil, fmt.Errorf("failed to update bucket settings: %w", err)
+	}
+
+	if err := awaitBucketSettled(ctx, a, &bucket, desired, opts.PollInterval); err != nil {
+		return nil, err
+	}
+
+	return &bucket, nil
+}
+
+// awaitBucketSettled blocks until the given bucket has the desired settings and is no longer pending, polling in
+// the given interval. Right after the update the Engine may still return the previous settings in a settled state,
+// which is not the result of the update yet.
+func awaitBucketSettled(ctx context.Context, a api.API, bucket *Bucket, desired BucketSettings, interval time.Duration) error {
+	if interval <= 0 {
+		interval = defaultBucketSettingsPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Get(ctx, bucket); err != nil {
+			return fmt.Errorf("failed to get bucket: %w", err)
+		}
+
+		if bucket.State.IsError() {
+			return fmt.Errorf("%w: bucket %q", gs.ErrStateError, bucket.Identifier)
+		} else if !bucket.State.IsPending() && bucket.Settings() == desired {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
+
+// bucketSettings is a virtual Object used to update only the versioning and object lock settings of a Bucket.
+type bucketSettings struct {
+	BucketIdentifier   string `json:"-" anxcloud:"identifier"`
+	VersioningActive   *bool  `json:"versioning_active,omitempty"`
+	ObjectLockLifetime *int   `json:"object_lock_lifetime,omitempty"`
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// EndpointURL returns the URL of the Bucket the settings are applied to. Only Update operations are supported.
+func (s *bucketSettings) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationUpdate {
+		return nil, api.ErrOperationNotSupported
+	}
+
+	return url.Parse("/api/object_storage/v2/bucket")
+}
+
+// FilterAPIRequest modifies the HTTP method for UPDATE operations (Object Storage API uses PATCH, not PUT)
+func (s *bucketSettings) FilterAPIRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	req.Method = "PATCH"
+	return req, nil
+}
+
+// DecodeAPIResponse discards the returned Bucket, which is retrieved again while waiting for the settings to be applied.
+func (s *bucketSettings) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
+	_, err := io.Copy(io.Discard, data)
+	return err
+}
@@ -0,0 +1,233 @@
+package v2_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+	"go.anx.io/go-anxcloud/pkg/client"
+	"go.anx.io/go-anxcloud/pkg/utils/pointer"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket settings transitions", func() {
+	DescribeTable("ValidateBucketSettingsTransition",
+		func(current, desired objectstoragev2.BucketSettings, expected error) {
+			err := objectstoragev2.ValidateBucketSettingsTransition(current, desired)
+			if expected == nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expected))
+			}
+		},
+		Entry("enable versioning",
+			objectstoragev2.BucketSettings{}, objectstoragev2.BucketSettings{VersioningActive: true}, nil),
+		Entry("suspend versioning",
+			objectstoragev2.BucketSettings{VersioningActive: true}, objectstoragev2.BucketSettings{}, nil),
+		Entry("change object lock lifetime",
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 1},
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 7}, nil),
+		Entry("enable object lock on existing bucket",
+			objectstoragev2.BucketSettings{VersioningActive: true},
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 1}, objectstoragev2.ErrObjectLockOnlyOnCreate),
+		Entry("disable object lock",
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 1},
+			objectstoragev2.BucketSettings{VersioningActive: true}, objectstoragev2.ErrObjectLockCannotBeDisabled),
+		Entry("suspend versioning with object lock",
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 1},
+			objectstoragev2.BucketSettings{ObjectLockLifetime: 1}, objectstoragev2.ErrObjectLockRequiresVersioning),
+		Entry("object lock lifetime too high",
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 1},
+			objectstoragev2.BucketSettings{VersioningActive: true, ObjectLockLifetime: 8}, objectstoragev2.ErrObjectLockLifetimeOutOfRange),
+	)
+
+	It("rejects creating buckets with object lock but without versioning", func() {
+		b := objectstoragev2.Bucket{ObjectLockLifetime: pointer.Int(3)}
+		_, err := b.FilterAPIRequestBody(types.ContextWithOperation(context.TODO(), types.OperationCreate))
+		Expect(err).To(MatchError(objectstoragev2.ErrObjectLockRequiresVersioning))
+	})
+})
+
+var _ = Describe("Bucket settings helpers", func() {
+	var (
+		a   api.API
+		srv *ghttp.Server
+	)
+
+	const bucketPath = "/api/object_storage/v2/bucket/bucket-id"
+
+	pollOptions := objectstoragev2.BucketSettingsOptions{PollInterval: time.Millisecond}
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("enables versioning and waits for the bucket to be settled", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier":        "bucket-id",
+					"versioning_active": false,
+					"object_count":      "42",
+					"object_size":       1024,
+				}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PATCH", bucketPath),
+				ghttp.VerifyJSON(`{"versioning_active":true}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"identifier": "bucket-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier":        "bucket-id",
+					"versioning_active": true,
+					"state":             map[string]interface{}{"id": "0", "type": 0, "title": "OK"},
+				}),
+			),
+		)
+
+		bucket, err := objectstoragev2.EnableVersioning(context.TODO(), a, "bucket-id", pollOptions)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucket.VersioningActive).To(BeTrue())
+		Expect(srv.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("waits for the changed settings when the bucket still reports the previous ones", func() {
+		previous := map[string]interface{}{
+			"identifier":        "bucket-id",
+			"versioning_active": false,
+			"state":             map[string]interface{}{"id": "0", "type": 0, "title": "OK"},
+		}
+
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, previous),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PATCH", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"identifier": "bucket-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, previous),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier":        "bucket-id",
+					"versioning_active": true,
+					"state":             map[string]interface{}{"id": "0", "type": 0, "title": "OK"},
+				}),
+			),
+		)
+
+		bucket, err := objectstoragev2.EnableVersioning(context.TODO(), a, "bucket-id", pollOptions)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucket.VersioningActive).To(BeTrue())
+		Expect(srv.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("does not send a request when the settings are already active", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", bucketPath),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"identifier":           "bucket-id",
+				"versioning_active":    true,
+				"object_lock_lifetime": 2.0,
+			}),
+		))
+
+		bucket, err := objectstoragev2.SetObjectLockLifetime(context.TODO(), a, "bucket-id", 2, pollOptions)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bucket.ObjectLockEnabled()).To(BeTrue())
+		Expect(srv.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("validates the transition before sending the update", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", bucketPath),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"identifier":           "bucket-id",
+				"versioning_active":    true,
+				"object_lock_lifetime": 2,
+			}),
+		))
+
+		_, err := objectstoragev2.SuspendVersioning(context.TODO(), a, "bucket-id", pollOptions)
+		Expect(err).To(MatchError(objectstoragev2.ErrObjectLockRequiresVersioning))
+		Expect(srv.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("returns an error when the bucket enters the error state", func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"identifier": "bucket-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PATCH", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"identifier": "bucket-id"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", bucketPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier": "bucket-id",
+					"state":      map[string]interface{}{"id": "1", "type": 1, "title": "Error"},
+				}),
+			),
+		)
+
+		_, err := objectstoragev2.EnableVersioning(context.TODO(), a, "bucket-id", pollOptions)
+		Expect(err).To(MatchError(gs.ErrStateError))
+	})
+})
+
+var _ = Describe("Bucket usage", func() {
+	It("decodes object count and size into typed usage", func() {
+		b := objectstoragev2.Bucket{}
+		err := b.DecodeAPIResponse(context.TODO(), strings.NewReader(`{"object_count":"12","object_size":2048.0}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.Usage).To(Equal(objectstoragev2.BucketUsage{ObjectCount: 12, ObjectSize: 2048}))
+	})
+
+	It("decodes empty usage values as zero", func() {
+		b := objectstoragev2.Bucket{}
+		err := b.DecodeAPIResponse(context.TODO(), strings.NewReader(`{"object_count":"","object_size":null}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.Usage).To(BeZero())
+	})
+})
+
+var _ = Describe("Tenant quota", func() {
+	DescribeTable("QuotaRemaining",
+		func(t objectstoragev2.Tenant, remaining float64, hasQuota bool) {
+			r, ok := t.QuotaRemaining()
+			Expect(ok).To(Equal(hasQuota))
+			Expect(r).To(Equal(remaining))
+		},
+		Entry("no quota", objectstoragev2.Tenant{}, 0.0, false),
+		Entry("unlimited quota", objectstoragev2.Tenant{Quota: pointer.Float64(0.0)}, 0.0, false),
+		Entry("quota without usage", objectstoragev2.Tenant{Quota: pointer.Float64(100.0)}, 100.0, true),
+		Entry("quota with usage", objectstoragev2.Tenant{Quota: pointer.Float64(100.0), Usage: pointer.Float64(40.0)}, 60.0, true),
+		Entry("quota exceeded", objectstoragev2.Tenant{Quota: pointer.Float64(100.0), Usage: pointer.Float64(140.0)}, 0.0, true),
+	)
+})
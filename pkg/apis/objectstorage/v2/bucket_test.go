@@ -23,7 +23,7 @@ var _ = Describe("Bucket filtering", func() {
 
 			// Attributes parameter should always be present
 			Expect(q).To(HaveKey("attributes"))
-			Expect(q.Get("attributes")).To(Equal("name,state,region,object_count,object_size,backend,tenant,reseller,customer"))
+			Expect(q.Get("attributes")).To(Equal("name,state,region,object_count,object_size,versioning_active,object_lock_lifetime,description,backend,tenant,reseller,customer"))
 
 			if expectedKey != "" {
 				Expect(q).To(HaveKey("filters"))
@@ -12,7 +12,7 @@ import (
 	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook,ResponseDecodeHook,PatchHook:contract=bucketContractOptions
 
 // Bucket represents a bucket resource in the Object Storage API.
 type Bucket struct {
@@ -26,8 +26,10 @@ type Bucket struct {
 	Reseller           string                 `json:"reseller,omitempty"`
 	Customer           string                 `json:"customer,omitempty"`
 	Share              bool                   `json:"share,omitempty"`
+	AutomationRules    []AutomationRule       `json:"automation_rules,omitempty"`
 
 	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
 	State              *GenericAttributeState `json:"state,omitempty"`
 	Region             common.PartialResource `json:"region"`
 	ObjectCount        interface{}            `json:"object_count,omitempty"`
@@ -37,18 +39,33 @@ type Bucket struct {
 	ObjectLockLifetime *int                   `json:"object_lock_lifetime,omitempty"`
 	VersioningActive   bool                   `json:"versioning_active,omitempty"`
 	Embed              []string               `json:"-"`
+
+	// Usage contains the strongly typed values of ObjectCount and ObjectSize and is filled when
+	// decoding a Bucket returned by the Engine.
+	Usage BucketUsage `json:"-"`
 }
 
-// GetObjectCount returns the object count as a float64, handling both string and numeric values.
-func (b *Bucket) GetObjectCount() (float64, error) {
-	if b.ObjectCount == nil {
-		return 0, nil
-	}
+// BucketUsage contains the usage statistics of a Bucket.
+type BucketUsage struct {
+	// ObjectCount is the number of objects stored in the bucket.
+	ObjectCount uint64
 
-	switch v := b.ObjectCount.(type) {
+	// ObjectSize is the total size of all objects stored in the bucket in bytes.
+	ObjectSize uint64
+}
+
+// parseNumber converts the loosely typed numbers returned by the Engine, which can either be
+// JSON numbers or strings containing a number, to float64. Empty values are returned as 0.
+func parseNumber(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
 	case float64:
 		return v, nil
 	case string:
+		if v == "" {
+			return 0, nil
+		}
 		return strconv.ParseFloat(v, 64)
 	case int:
 		return float64(v), nil
@@ -59,24 +76,14 @@ func (b *Bucket) GetObjectCount() (float64, error) {
 	}
 }
 
+// GetObjectCount returns the object count as a float64, handling both string and numeric values.
+func (b *Bucket) GetObjectCount() (float64, error) {
+	return parseNumber(b.ObjectCount)
+}
+
 // GetObjectSize returns the object size as a float64, handling both string and numeric values.
 func (b *Bucket) GetObjectSize() (float64, error) {
-	if b.ObjectSize == nil {
-		return 0, nil
-	}
-
-	switch v := b.ObjectSize.(type) {
-	case float64:
-		return v, nil
-	case string:
-		return strconv.ParseFloat(v, 64)
-	case int:
-		return float64(v), nil
-	case int64:
-		return float64(v), nil
-	default:
-		return 0, nil
-	}
+	return parseNumber(b.ObjectSize)
 }
 
 // DecodeAPIResponse handles custom JSON unmarshaling for Bucket to fix type mismatches
@@ -93,14 +100,18 @@ func (b *Bucket) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
 		Reseller           string                 `json:"reseller,omitempty"`
 		Customer           string                 `json:"customer,omitempty"`
 		Share              bool                   `json:"share,omitempty"`
-
-		Name        string                 `json:"name"`
-		State       *GenericAttributeState `json:"state,omitempty"`
-		Region      common.PartialResource `json:"region"`
-		ObjectCount interface{}            `json:"object_count,omitempty"`
-		ObjectSize  interface{}            `json:"object_size,omitempty"`
-		Backend     common.PartialResource `json:"backend"`
-		Tenant      common.PartialResource `json:"tenant"`
+		AutomationRules    []AutomationRule       `json:"automation_rules,omitempty"`
+
+		Name               string                 `json:"name"`
+		Description        *string                `json:"description,omitempty"`
+		State              *GenericAttributeState `json:"state,omitempty"`
+		Region             common.PartialResource `json:"region"`
+		ObjectCount        interface{}            `json:"object_count,omitempty"`
+		ObjectSize         interface{}            `json:"object_size,omitempty"`
+		Backend            common.PartialResource `json:"backend"`
+		Tenant             common.PartialResource `json:"tenant"`
+		ObjectLockLifetime interface{}            `json:"object_lock_lifetime,omitempty"`
+		VersioningActive   *bool                  `json:"versioning_active,omitempty"`
 	}
 
 	// Unmarshal into the temp struct
@@ -118,7 +129,12 @@ func (b *Bucket) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
 	b.Reseller = temp.Reseller
 	b.Customer = temp.Customer
 	b.Share = temp.Share
+	b.AutomationRules = temp.AutomationRules
 	b.Name = temp.Name
+	b.Description = ""
+	if temp.Description != nil {
+		b.Description = *temp.Description
+	}
 	b.State = temp.State
 	b.Region = temp.Region
 	b.Backend = temp.Backend
@@ -128,23 +144,64 @@ func (b *Bucket) DecodeAPIResponse(ctx context.Context, data io.Reader) error {
 	b.ObjectCount = temp.ObjectCount
 	b.ObjectSize = temp.ObjectSize
 
+	objectCount, err := parseNumber(temp.ObjectCount)
+	if err != nil {
+		return fmt.Errorf("failed to decode bucket object_count: %w", err)
+	}
+
+	objectSize, err := parseNumber(temp.ObjectSize)
+	if err != nil {
+		return fmt.Errorf("failed to decode bucket object_size: %w", err)
+	}
+
+	b.Usage = BucketUsage{
+		ObjectCount: uint64(objectCount),
+		ObjectSize:  uint64(objectSize),
+	}
+
+	b.VersioningActive = temp.VersioningActive != nil && *temp.VersioningActive
+
+	b.ObjectLockLifetime = nil
+	if temp.ObjectLockLifetime != nil {
+		lifetime, err := parseNumber(temp.ObjectLockLifetime)
+		if err != nil {
+			return fmt.Errorf("failed to decode bucket object_lock_lifetime: %w", err)
+		}
+
+		days := int(lifetime)
+		b.ObjectLockLifetime = &days
+	}
+
 	return nil
 }
 
 // bucketEmptyAndDelete represents the trigger object for empty and delete operations
 type bucketEmptyAndDelete struct {
-	BucketIdentifier string `json:"-" anxcloud:"identifier"`
-	EmptyAndDelete   bool   `json:"empty_and_delete"`
+	BucketIdentifier  string `json:"-" anxcloud:"identifier"`
+	EmptyAndDelete    bool   `json:"empty_and_delete"`
+	ProcessIdentifier string `json:"automation_rule_process_identifier,omitempty"`
 }
 
 // EmptyAndDelete empties the bucket and then deletes it using the trigger/empty_and_delete endpoint.
 // This is the proper way to delete a bucket that contains objects.
 func EmptyAndDelete(ctx context.Context, a api.API, bucketID string) error {
+	_, err := TriggerEmptyAndDelete(ctx, a, bucketID)
+	return err
+}
+
+// TriggerEmptyAndDelete empties and deletes the bucket like EmptyAndDelete, returning the identifier of the
+// started automation rule process, which can be passed to AwaitAutomationRuleProcess.
+func TriggerEmptyAndDelete(ctx context.Context, a api.API, bucketID string) (string, error) {
 	trigger := &bucketEmptyAndDelete{
 		BucketIdentifier: bucketID,
 		EmptyAndDelete:   true,
 	}
-	return a.Create(ctx, trigger)
+
+	if err := a.Create(ctx, trigger); err != nil {
+		return "", err
+	}
+
+	return trigger.ProcessIdentifier, nil
 }
 
 // EmptyAndDelete is a convenience method that calls the package-level EmptyAndDelete function
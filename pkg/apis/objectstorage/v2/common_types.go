@@ -1,6 +1,8 @@
 package v2
 
 import (
+	"strings"
+
 	"go.anx.io/go-anxcloud/pkg/apis/common"
 )
 
@@ -14,25 +16,59 @@ type AutomationRule struct {
 	Config         map[string]string `json:"config,omitempty"`
 }
 
-// AutomationRuleProcess represents the status of an automation rule process.
+// anxcloud:object:nocontract
+
+// AutomationRuleProcess represents the status of an automation rule process. It can be retrieved with a Get
+// operation when Identifier, ResourceType and the Identifier of ResourceReference are set.
 type AutomationRuleProcess struct {
-	Identifier        string                      `json:"identifier,omitempty"`
-	Rule              AutomationRule              `json:"rule,omitempty"`
-	Status            AutomationRuleProcessStatus `json:"status,omitempty"`
-	Message           *string                     `json:"message,omitempty"`
-	Progress          int                         `json:"progress,omitempty"`
-	CreatedAt         string                      `json:"created_at,omitempty"`
-	UpdatedAt         string                      `json:"updated_at,omitempty"`
-	ProcessTasks      []AutomationRuleProcessTask `json:"process_tasks,omitempty"`
-	ResourceReference common.PartialResource      `json:"resource_reference,omitempty"`
+	Identifier        string                         `json:"identifier,omitempty" anxcloud:"identifier"`
+	Rule              AutomationRule                 `json:"rule,omitempty"`
+	Status            AutomationRuleProcessStatus    `json:"status,omitempty"`
+	Message           *string                        `json:"message,omitempty"`
+	Progress          int                            `json:"progress,omitempty"`
+	CreatedAt         string                         `json:"created_at,omitempty"`
+	UpdatedAt         string                         `json:"updated_at,omitempty"`
+	ProcessTasks      []AutomationRuleProcessTask    `json:"process_tasks,omitempty"`
+	TaskInfo          *AutomationRuleProcessTaskInfo `json:"task_info,omitempty"`
+	ResourceReference common.PartialResource         `json:"resource_reference,omitempty"`
+
+	// ResourceType is the type of the resource the process is running for.
+	ResourceType AutomationResourceType `json:"-"`
 }
 
+// Status types of automation rule processes as reported by the Engine.
+const (
+	AutomationRuleProcessStatusPending   = "Pending"
+	AutomationRuleProcessStatusRunning   = "Running"
+	AutomationRuleProcessStatusSuccess   = "Success"
+	AutomationRuleProcessStatusFailed    = "Failed"
+	AutomationRuleProcessStatusError     = "Error"
+	AutomationRuleProcessStatusCancelled = "Cancelled"
+)
+
 // AutomationRuleProcessStatus represents the status of an automation rule process.
 type AutomationRuleProcessStatus struct {
 	StatusCode int    `json:"status_code"`
 	StatusType string `json:"status_type"`
 }
 
+// IsSuccess returns true if the process or task finished successfully.
+func (s AutomationRuleProcessStatus) IsSuccess() bool {
+	return strings.EqualFold(s.StatusType, AutomationRuleProcessStatusSuccess)
+}
+
+// IsFailed returns true if the process or task failed or was cancelled.
+func (s AutomationRuleProcessStatus) IsFailed() bool {
+	return strings.EqualFold(s.StatusType, AutomationRuleProcessStatusFailed) ||
+		strings.EqualFold(s.StatusType, AutomationRuleProcessStatusError) ||
+		strings.EqualFold(s.StatusType, AutomationRuleProcessStatusCancelled)
+}
+
+// IsTerminal returns true if the process or task will not change its status anymore.
+func (s AutomationRuleProcessStatus) IsTerminal() bool {
+	return s.IsSuccess() || s.IsFailed()
+}
+
 // AutomationRuleProcessTask represents a task within an automation rule process.
 type AutomationRuleProcessTask struct {
 	Identifier string                         `json:"identifier,omitempty"`
@@ -43,8 +79,14 @@ type AutomationRuleProcessTask struct {
 
 // AutomationRuleProcessTaskInfo contains additional information about a process task.
 type AutomationRuleProcessTaskInfo struct {
-	Type   string      `json:"type,omitempty"`
-	Config interface{} `json:"config,omitempty"`
+	Type       string      `json:"type,omitempty"`
+	Config     interface{} `json:"config,omitempty"`
+	ID         string      `json:"id,omitempty"`
+	Progress   int         `json:"progress,omitempty"`
+	Error      *string     `json:"error,omitempty"`
+	StatusText *string     `json:"status_text,omitempty"`
+	StartedAt  *string     `json:"started_at,omitempty"`
+	FinishedAt *string     `json:"finished_at,omitempty"`
 }
 
 // GenericAttributeState represents a state attribute in GS API responses.
@@ -79,6 +121,21 @@ func (s *GenericAttributeState) String() string {
 	}
 }
 
+// IsOK returns true if the state is of type OK. A nil state is considered OK.
+func (s *GenericAttributeState) IsOK() bool {
+	return s == nil || s.Type == 0
+}
+
+// IsError returns true if the state is of type Error.
+func (s *GenericAttributeState) IsError() bool {
+	return s != nil && s.Type == 1
+}
+
+// IsPending returns true if the state is of type Pending, marking a change currently being applied.
+func (s *GenericAttributeState) IsPending() bool {
+	return s != nil && s.Type == 2
+}
+
 // GenericAttributeSelect represents a select attribute in GS API responses.
 type GenericAttributeSelect struct {
 	Identifier string `json:"identifier,omitempty"`
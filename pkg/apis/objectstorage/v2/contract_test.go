@@ -0,0 +1,20 @@
+package v2_test
+
+import (
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+	"go.anx.io/go-anxcloud/pkg/utils/pointer"
+	testutils "go.anx.io/go-anxcloud/pkg/utils/test"
+)
+
+// bucketContractOptions configures the contract tests of Buckets, which require versioning for the object lock
+// and limit its lifetime.
+func bucketContractOptions() []testutils.ContractOption {
+	return []testutils.ContractOption{
+		testutils.ContractPrepare(func(o types.Object, _ types.Operation) {
+			b := o.(*objectstoragev2.Bucket)
+			b.VersioningActive = true
+			b.ObjectLockLifetime = pointer.Int(objectstoragev2.MaxObjectLockLifetime)
+		}),
+	}
+}
@@ -22,6 +22,6 @@ type Endpoint struct {
 	URL              string                 `json:"url,omitempty"`
 	State            *GenericAttributeState `json:"state,omitempty"`
 	EndpointUser     string                 `json:"endpoint_user,omitempty"`
-	EndpointPassword string                 `json:"endpoint_password,omitempty"`
+	EndpointPassword string                 `json:"endpoint_password,omitempty" anxcloud:"secret"`
 	Enabled          bool                   `json:"enabled,omitempty"`
 }
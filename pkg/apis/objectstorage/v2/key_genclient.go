@@ -25,7 +25,7 @@ func (k *Key) EndpointURL(ctx context.Context) (*url.URL, error) {
 		query := u.Query()
 
 		// Add attributes parameter to get all fields
-		query.Add("attributes", "name,remote_id,state,backend,tenant,user,expire_date,secret_url,reseller,customer")
+		query.Add("attributes", "name,remote_id,state,backend,tenant,user,expiry_date,secret_url,reseller,customer")
 
 		filters := make(url.Values)
 
@@ -144,3 +144,8 @@ func (k *Key) FilterAPIRequest(ctx context.Context, req *http.Request) (*http.Re
 
 	return req, nil
 }
+
+// SupportsPatch returns true as the Object Storage API accepts PATCH requests containing only the changed fields.
+func (k *Key) SupportsPatch(ctx context.Context) bool {
+	return true
+}
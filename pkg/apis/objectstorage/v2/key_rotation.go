@@ -0,0 +1,298 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/apis/common"
+	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
+)
+
+var (
+	// ErrKeyRotationDeployRequired is returned when calling RotateKey without a Deploy callback.
+	ErrKeyRotationDeployRequired = errors.New("key rotation requires a Deploy callback")
+
+	// ErrKeySecretUnavailable is returned when the secret of a Key cannot be retrieved.
+	ErrKeySecretUnavailable = errors.New("key secret is not available")
+)
+
+// keyRotationPollInterval is the interval in which a newly created Key is retrieved while waiting for it to be ready.
+const keyRotationPollInterval = 5 * time.Second
+
+// KeySecret contains the credentials of an access Key.
+type KeySecret struct {
+	// AccessKey is the public part of the credentials, the access key ID.
+	AccessKey string `json:"access_key"`
+
+	// SecretKey is the secret part of the credentials.
+	SecretKey string `json:"secret_key" anxcloud:"secret"`
+}
+
+// KeySecretRetriever retrieves the secret of the given Key, which has its SecretURL set.
+type KeySecretRetriever func(ctx context.Context, key *Key) (KeySecret, error)
+
+// HTTPKeySecretRetriever returns a KeySecretRetriever fetching the secret from the SecretURL of the Key with the
+// given HTTP client, http.DefaultClient is used when nil is passed. A JSON response is decoded into KeySecret,
+// any other response body is used as SecretKey with the RemoteID of the Key as AccessKey.
+func HTTPKeySecretRetriever(c *http.Client) KeySecretRetriever {
+	if c == nil {
+		c = http.DefaultClient
+	}
+
+	return func(ctx context.Context, key *Key) (KeySecret, error) {
+		if key.SecretURL == "" {
+			return KeySecret{}, fmt.Errorf("%w: key %q has no secret URL", ErrKeySecretUnavailable, key.Identifier)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, key.SecretURL, nil)
+		if err != nil {
+			return KeySecret{}, err
+		}
+		req.Header.Set("Accept", "application/json, text/plain")
+
+		res, err := c.Do(req)
+		if err != nil {
+			return KeySecret{}, fmt.Errorf("%w: %w", ErrKeySecretUnavailable, err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			return KeySecret{}, fmt.Errorf("%w: secret URL returned %v", ErrKeySecretUnavailable, res.Status)
+		}
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return KeySecret{}, fmt.Errorf("%w: %w", ErrKeySecretUnavailable, err)
+		}
+
+		secret := KeySecret{}
+		if err := json.Unmarshal(body, &secret); err != nil || secret.SecretKey == "" {
+			secret = KeySecret{SecretKey: strings.TrimSpace(string(body))}
+		}
+
+		if secret.AccessKey == "" && key.RemoteID != nil {
+			secret.AccessKey = *key.RemoteID
+		}
+
+		if secret.SecretKey == "" {
+			return KeySecret{}, fmt.Errorf("%w: secret URL returned an empty secret", ErrKeySecretUnavailable)
+		}
+
+		return secret, nil
+	}
+}
+
+// KeyRotationOptions configures the RotateKey workflow.
+type KeyRotationOptions struct {
+	// Name of the new key, a name containing the user name and the current time is generated if empty.
+	Name string
+
+	// Validity configures the expiry date of the new key relative to its creation, the key does not expire if 0.
+	Validity time.Duration
+
+	// RetrieveSecret retrieves the secret of the new key, HTTPKeySecretRetriever(nil) is used if nil.
+	RetrieveSecret KeySecretRetriever
+
+	// Deploy is called with the new key and its secret to deploy it wherever it is used. Required.
+	Deploy func(ctx context.Context, key *Key, secret KeySecret) error
+
+	// Verify is called after the new key was deployed to check if it is working, optional.
+	Verify func(ctx context.Context, key *Key, secret KeySecret) error
+
+	// RevokeKeys are the identifiers of the keys to revoke after the new key was deployed. All other keys
+	// of the user are revoked if empty.
+	RevokeKeys []string
+
+	// KeepOldKeys disables revoking old keys, RevokeKeys is ignored then.
+	KeepOldKeys bool
+}
+
+// KeyRotationResult contains the outcome of a RotateKey call.
+type KeyRotationResult struct {
+	// NewKey is the newly created and deployed key.
+	NewKey *Key
+
+	// RevokedKeys contains the identifiers of the successfully revoked old keys.
+	RevokedKeys []string
+}
+
+// RotateKey creates a new Key for the given User, retrieves its secret and calls opts.Deploy to deploy it.
+// After optionally verifying the new key with opts.Verify, the old keys of the user are revoked. The new key
+// is destroyed again if retrieving its secret, deploying or verifying it fails. Errors revoking old keys are
+// returned joined, together with the result containing the new key.
+func RotateKey(ctx context.Context, a api.API, user *User, opts KeyRotationOptions) (*KeyRotationResult, error) {
+	if opts.Deploy == nil {
+		return nil, ErrKeyRotationDeployRequired
+	}
+
+	if user.Identifier == "" {
+		return nil, types.ErrUnidentifiedObject
+	}
+
+	if opts.RetrieveSecret == nil {
+		opts.RetrieveSecret = HTTPKeySecretRetriever(nil)
+	}
+
+	if err := a.Get(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	oldKeys := opts.RevokeKeys
+	if len(oldKeys) == 0 && !opts.KeepOldKeys {
+		keys, err := listKeys(ctx, a, &Key{User: &common.PartialResource{Identifier: user.Identifier}})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range keys {
+			oldKeys = append(oldKeys, k.Identifier)
+		}
+	}
+
+	now := time.Now()
+	key := &Key{
+		Name:    opts.Name,
+		Backend: &common.PartialResource{Identifier: user.Backend.Identifier},
+		Tenant:  &common.PartialResource{Identifier: user.Tenant.Identifier},
+		User:    &common.PartialResource{Identifier: user.Identifier},
+
+		CustomerIdentifier: user.CustomerIdentifier,
+		ResellerIdentifier: user.ResellerIdentifier,
+	}
+
+	if key.Name == "" {
+		key.Name = fmt.Sprintf("%s-%s", user.UserName, now.UTC().Format("20060102150405"))
+	}
+
+	if opts.Validity > 0 {
+		expiry := now.Add(opts.Validity).UTC()
+		key.ExpireDate = &expiry
+	}
+
+	if err := a.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to create key: %w", err)
+	}
+
+	rollback := func(cause error) error {
+		if err := a.Destroy(ctx, &Key{Identifier: key.Identifier}); api.IgnoreNotFound(err) != nil {
+			return errors.Join(cause, fmt.Errorf("failed to roll back new key %q: %w", key.Identifier, err))
+		}
+
+		return cause
+	}
+
+	if err := awaitKeyReady(ctx, a, key); err != nil {
+		return nil, rollback(err)
+	}
+
+	secret, err := opts.RetrieveSecret(ctx, key)
+	if err != nil {
+		return nil, rollback(fmt.Errorf("failed to retrieve key secret: %w", err))
+	}
+
+	if err := opts.Deploy(ctx, key, secret); err != nil {
+		return nil, rollback(fmt.Errorf("failed to deploy new key: %w", err))
+	}
+
+	if opts.Verify != nil {
+		if err := opts.Verify(ctx, key, secret); err != nil {
+			return nil, rollback(fmt.Errorf("failed to verify new key: %w", err))
+		}
+	}
+
+	result := &KeyRotationResult{NewKey: key}
+
+	if opts.KeepOldKeys {
+		return result, nil
+	}
+
+	var errs error
+	for _, identifier := range oldKeys {
+		if identifier == key.Identifier {
+			continue
+		}
+
+		if err := a.Destroy(ctx, &Key{Identifier: identifier}); api.IgnoreNotFound(err) != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to revoke key %q: %w", identifier, err))
+			continue
+		}
+
+		result.RevokedKeys = append(result.RevokedKeys, identifier)
+	}
+
+	return result, errs
+}
+
+// ListExpiringKeys retrieves all keys matching the given filter Key which expire within the given duration,
+// including already expired keys. Keys without expiry date are never returned.
+func ListExpiringKeys(ctx context.Context, a api.API, filter *Key, within time.Duration) ([]Key, error) {
+	keys, err := listKeys(ctx, a, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(within)
+	expiring := make([]Key, 0)
+	for _, k := range keys {
+		if k.ExpireDate != nil && k.ExpireDate.Before(deadline) {
+			expiring = append(expiring, k)
+		}
+	}
+
+	return expiring, nil
+}
+
+func listKeys(ctx context.Context, a api.API, filter *Key) ([]Key, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var channel types.ObjectChannel
+	if err := a.List(ctx, filter, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+
+	keys := make([]Key, 0)
+	for retriever := range channel {
+		var key Key
+		if err := retriever(&key); err != nil {
+			return nil, fmt.Errorf("failed to retrieve key: %w", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// awaitKeyReady blocks until the given key is no longer pending and has its secret URL set.
+func awaitKeyReady(ctx context.Context, a api.API, key *Key) error {
+	ticker := time.NewTicker(keyRotationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Get(ctx, key); err != nil {
+			return fmt.Errorf("failed to get key: %w", err)
+		}
+
+		if key.State.IsError() {
+			return fmt.Errorf("%w: key %q", gs.ErrStateError, key.Identifier)
+		} else if !key.State.IsPending() && key.SecretURL != "" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			continue
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"go.anx.io/go-anxcloud/pkg/api"
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+	"go.anx.io/go-anxcloud/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Key rotation", func() {
+	var (
+		a   api.API
+		srv *ghttp.Server
+	)
+
+	const (
+		userPath = "/api/object_storage/v2/user/user-id"
+		keyPath  = "/api/object_storage/v2/key"
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		var err error
+		a, err = api.NewAPI(api.WithClientOptions(
+			client.BaseURL(srv.URL()),
+			client.IgnoreMissingToken(),
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	keyListPage := func(page int, keys ...map[string]interface{}) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", keyPath),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"page":        page,
+				"total_pages": 1,
+				"total_items": len(keys),
+				"limit":       10,
+				"data":        keys,
+			}),
+		)
+	}
+
+	appendKeyList := func(keys ...map[string]interface{}) {
+		srv.AppendHandlers(keyListPage(1, keys...), keyListPage(2))
+	}
+
+	appendUserAndKeyCreation := func() {
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", userPath),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier": "user-id",
+					"user_name":  "deploy",
+					"backend":    map[string]interface{}{"identifier": "backend-id"},
+					"tenant":     map[string]interface{}{"identifier": "tenant-id"},
+				}),
+			),
+		)
+		appendKeyList(map[string]interface{}{"identifier": "old-key", "name": "old"})
+		srv.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", keyPath),
+				ghttp.VerifyJSON(`{"name":"rotated","backend":"backend-id","tenant":"tenant-id","user":"user-id","customer_identifier":""}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"identifier": "new-key", "name": "rotated"}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", keyPath+"/new-key"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"identifier": "new-key",
+					"name":       "rotated",
+					"remote_id":  "AKIAEXAMPLE",
+					"secret_url": "https://secret.example.com/new-key",
+					"state":      map[string]interface{}{"id": "0", "text": "OK", "type": 0},
+				}),
+			),
+		)
+	}
+
+	retrieveSecret := func(_ context.Context, k *objectstoragev2.Key) (objectstoragev2.KeySecret, error) {
+		Expect(k.SecretURL).To(Equal("https://secret.example.com/new-key"))
+		return objectstoragev2.KeySecret{AccessKey: *k.RemoteID, SecretKey: "secret"}, nil
+	}
+
+	It("requires a Deploy callback", func() {
+		_, err := objectstoragev2.RotateKey(context.TODO(), a, &objectstoragev2.User{Identifier: "user-id"}, objectstoragev2.KeyRotationOptions{})
+		Expect(err).To(MatchError(objectstoragev2.ErrKeyRotationDeployRequired))
+	})
+
+	It("deploys the new key and revokes the old ones", func() {
+		appendUserAndKeyCreation()
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("DELETE", keyPath+"/old-key"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{}),
+		))
+
+		var deployed objectstoragev2.KeySecret
+		res, err := objectstoragev2.RotateKey(context.TODO(), a, &objectstoragev2.User{Identifier: "user-id"}, objectstoragev2.KeyRotationOptions{
+			Name:           "rotated",
+			RetrieveSecret: retrieveSecret,
+			Deploy: func(_ context.Context, _ *objectstoragev2.Key, s objectstoragev2.KeySecret) error {
+				deployed = s
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.NewKey.Identifier).To(Equal("new-key"))
+		Expect(res.RevokedKeys).To(ConsistOf("old-key"))
+		Expect(deployed).To(Equal(objectstoragev2.KeySecret{AccessKey: "AKIAEXAMPLE", SecretKey: "secret"}))
+	})
+
+	It("rolls back the new key when verification fails", func() {
+		appendUserAndKeyCreation()
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("DELETE", keyPath+"/new-key"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{}),
+		))
+
+		verifyErr := errors.New("access denied")
+		_, err := objectstoragev2.RotateKey(context.TODO(), a, &objectstoragev2.User{Identifier: "user-id"}, objectstoragev2.KeyRotationOptions{
+			Name:           "rotated",
+			RetrieveSecret: retrieveSecret,
+			Deploy:         func(context.Context, *objectstoragev2.Key, objectstoragev2.KeySecret) error { return nil },
+			Verify:         func(context.Context, *objectstoragev2.Key, objectstoragev2.KeySecret) error { return verifyErr },
+		})
+		Expect(err).To(MatchError(verifyErr))
+		Expect(srv.ReceivedRequests()).To(HaveLen(6))
+	})
+
+	It("retrieves secrets from the secret URL", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/secret/new-key"),
+			ghttp.RespondWith(http.StatusOK, "plain-secret\n"),
+		))
+
+		remoteID := "AKIAEXAMPLE"
+		secret, err := objectstoragev2.HTTPKeySecretRetriever(nil)(context.TODO(), &objectstoragev2.Key{
+			RemoteID:  &remoteID,
+			SecretURL: srv.URL() + "/secret/new-key",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret).To(Equal(objectstoragev2.KeySecret{AccessKey: "AKIAEXAMPLE", SecretKey: "plain-secret"}))
+	})
+
+	It("lists keys expiring within the given duration", func() {
+		soon := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+		later := time.Now().Add(90 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+		appendKeyList(
+			map[string]interface{}{"identifier": "soon", "expiry_date": soon},
+			map[string]interface{}{"identifier": "later", "expiry_date": later},
+			map[string]interface{}{"identifier": "never"},
+		)
+
+		keys, err := objectstoragev2.ListExpiringKeys(context.TODO(), a, &objectstoragev2.Key{}, 7*24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0].Identifier).To(Equal("soon"))
+	})
+})
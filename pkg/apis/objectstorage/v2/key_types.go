@@ -6,7 +6,7 @@ import (
 	"time"
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook,PatchHook
 
 // Key represents a key resource in the Object Storage API.
 type Key struct {
@@ -0,0 +1,130 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// EndpointURL returns the URL where to retrieve objects of type LifecycleRule and the identifier of the given LifecycleRule.
+// It implements the api.Object interface on *LifecycleRule, making it usable with the generic API client.
+func (r *LifecycleRule) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse("/api/object_storage/v2/lifecycle_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	if op == types.OperationList {
+		query := u.Query()
+
+		// Add attributes parameter to get all fields
+		query.Add("attributes", "name,state,bucket,prefix,enabled,expiration_days,noncurrent_version_expiration_days,reseller,customer")
+
+		filters := make(url.Values)
+
+		if r.State != nil && r.State.ID != "" {
+			filters.Add("state", r.State.ID)
+		}
+
+		if r.Bucket.Identifier != "" {
+			filters.Add("bucket", r.Bucket.Identifier)
+		}
+
+		if r.Enabled != nil {
+			filters.Add("enabled", strconv.FormatBool(*r.Enabled))
+		}
+
+		if r.CustomerIdentifier != "" {
+			filters.Add("customer", r.CustomerIdentifier)
+		}
+
+		if r.ResellerIdentifier != "" {
+			filters.Add("reseller", r.ResellerIdentifier)
+		}
+
+		if len(filters) > 0 {
+			query.Add("filters", filters.Encode())
+		}
+
+		u.RawQuery = query.Encode()
+	}
+
+	return u, nil
+}
+
+// FilterAPIRequestBody generates the request body for LifecycleRules, replacing the linked Bucket with just its identifier.
+func (r *LifecycleRule) FilterAPIRequestBody(ctx context.Context) (interface{}, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op == types.OperationCreate || op == types.OperationUpdate {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	body := requestBody(ctx, func() interface{} {
+		reqBody := &struct {
+			Name                            string  `json:"name,omitempty"`
+			Bucket                          string  `json:"bucket,omitempty"`
+			Prefix                          string  `json:"prefix,omitempty"`
+			Enabled                         *bool   `json:"enabled,omitempty"`
+			ExpirationDays                  *int    `json:"expiration_days,omitempty"`
+			NoncurrentVersionExpirationDays *int    `json:"noncurrent_version_expiration_days,omitempty"`
+			CustomerIdentifier              *string `json:"customer_identifier,omitempty"`
+			ResellerIdentifier              *string `json:"reseller_identifier,omitempty"`
+		}{
+			Name:                            r.Name,
+			Prefix:                          r.Prefix,
+			Enabled:                         r.Enabled,
+			ExpirationDays:                  r.ExpirationDays,
+			NoncurrentVersionExpirationDays: r.NoncurrentVersionExpirationDays,
+		}
+
+		// the bucket of a lifecycle rule cannot be changed
+		if op == types.OperationCreate {
+			reqBody.Bucket = r.Bucket.Identifier
+		}
+
+		if r.CustomerIdentifier != "" {
+			reqBody.CustomerIdentifier = &r.CustomerIdentifier
+		}
+
+		if r.ResellerIdentifier != "" {
+			reqBody.ResellerIdentifier = &r.ResellerIdentifier
+		}
+
+		return reqBody
+	})
+
+	return body, nil
+}
+
+// FilterAPIRequest modifies the HTTP method for UPDATE operations (Object Storage API uses PATCH, not PUT)
+func (r *LifecycleRule) FilterAPIRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op == types.OperationUpdate {
+		req.Method = "PATCH"
+	}
+
+	return req, nil
+}
+
+// SupportsPatch returns true as the Object Storage API accepts PATCH requests containing only the changed fields.
+func (r *LifecycleRule) SupportsPatch(ctx context.Context) bool {
+	return true
+}
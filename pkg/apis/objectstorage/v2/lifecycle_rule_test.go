@@ -0,0 +1,71 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/apis/common"
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+	"go.anx.io/go-anxcloud/pkg/utils/pointer"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LifecycleRule", func() {
+	DescribeTable("Validate",
+		func(r objectstoragev2.LifecycleRule, expected error) {
+			err := r.Validate()
+			if expected == nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expected))
+			}
+		},
+		Entry("expiration", objectstoragev2.LifecycleRule{ExpirationDays: pointer.Int(30)}, nil),
+		Entry("noncurrent version expiration", objectstoragev2.LifecycleRule{NoncurrentVersionExpirationDays: pointer.Int(7)}, nil),
+		Entry("no action", objectstoragev2.LifecycleRule{}, objectstoragev2.ErrLifecycleRuleWithoutAction),
+		Entry("zero days", objectstoragev2.LifecycleRule{ExpirationDays: pointer.Int(0)}, objectstoragev2.ErrLifecycleRuleInvalidDays),
+		Entry("negative noncurrent days", objectstoragev2.LifecycleRule{
+			ExpirationDays:                  pointer.Int(1),
+			NoncurrentVersionExpirationDays: pointer.Int(-1),
+		}, objectstoragev2.ErrLifecycleRuleInvalidDays),
+	)
+
+	It("filters by bucket when listing", func() {
+		r := objectstoragev2.LifecycleRule{Bucket: common.PartialResource{Identifier: "bucket-id"}}
+		u, err := r.EndpointURL(types.ContextWithOperation(context.TODO(), types.OperationList))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.Path).To(Equal("/api/object_storage/v2/lifecycle_rule"))
+
+		filters, err := url.ParseQuery(u.Query().Get("filters"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters.Get("bucket")).To(Equal("bucket-id"))
+	})
+
+	It("requires versioning for noncurrent version expiration", func() {
+		err := objectstoragev2.AddLifecycleRule(context.TODO(), nil,
+			&objectstoragev2.Bucket{Identifier: "bucket-id"},
+			&objectstoragev2.LifecycleRule{NoncurrentVersionExpirationDays: pointer.Int(7)},
+		)
+		Expect(err).To(MatchError(objectstoragev2.ErrLifecycleRuleRequiresVersioning))
+	})
+
+	It("sends the bucket identifier on create", func() {
+		r := objectstoragev2.LifecycleRule{
+			Name:           "expire-logs",
+			Bucket:         common.PartialResource{Identifier: "bucket-id"},
+			Prefix:         "logs/",
+			ExpirationDays: pointer.Int(14),
+		}
+
+		body, err := r.FilterAPIRequestBody(types.ContextWithOperation(context.TODO(), types.OperationCreate))
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"name":"expire-logs","bucket":"bucket-id","prefix":"logs/","expiration_days":14}`))
+	})
+})
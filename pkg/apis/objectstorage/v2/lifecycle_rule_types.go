@@ -0,0 +1,116 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/apis/common"
+	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
+)
+
+var (
+	// ErrLifecycleRuleWithoutAction is returned when a LifecycleRule neither expires current nor noncurrent object versions.
+	ErrLifecycleRuleWithoutAction = errors.New("lifecycle rule has to configure an expiration or a noncurrent version expiration")
+
+	// ErrLifecycleRuleInvalidDays is returned when a LifecycleRule is configured with a non-positive number of days.
+	ErrLifecycleRuleInvalidDays = errors.New("lifecycle rule expiration days have to be greater than 0")
+
+	// ErrLifecycleRuleRequiresVersioning is returned when adding a LifecycleRule expiring noncurrent object versions to
+	// a bucket without versioning being active.
+	ErrLifecycleRuleRequiresVersioning = errors.New("noncurrent version expiration requires versioning to be active on the bucket")
+)
+
+// anxcloud:object:hooks=RequestBodyHook,PatchHook
+
+// LifecycleRule represents a lifecycle rule of a Bucket in the Object Storage API. Lifecycle rules expire
+// objects (optionally limited to a key prefix) and noncurrent object versions after a number of days.
+// Rules are applied asynchronously by the Engine, use State and AutomationRules to track them.
+type LifecycleRule struct {
+	gs.GenericService
+	gs.HasState
+
+	CustomerIdentifier string           `json:"customer_identifier,omitempty"`
+	ResellerIdentifier string           `json:"reseller_identifier,omitempty"`
+	Identifier         string           `json:"identifier,omitempty" anxcloud:"identifier"`
+	Reseller           string           `json:"reseller,omitempty"`
+	Customer           string           `json:"customer,omitempty"`
+	AutomationRules    []AutomationRule `json:"automation_rules,omitempty"`
+
+	Name    string                 `json:"name"`
+	State   *GenericAttributeState `json:"state,omitempty"`
+	Bucket  common.PartialResource `json:"bucket"`
+	Prefix  string                 `json:"prefix,omitempty"`
+	Enabled *bool                  `json:"enabled,omitempty"`
+
+	// ExpirationDays configures after how many days current object versions expire.
+	ExpirationDays *int `json:"expiration_days,omitempty"`
+
+	// NoncurrentVersionExpirationDays configures after how many days noncurrent object versions are removed.
+	// Only valid for buckets with versioning active.
+	NoncurrentVersionExpirationDays *int `json:"noncurrent_version_expiration_days,omitempty"`
+}
+
+// Validate checks if the LifecycleRule configures at least one valid expiration.
+func (r *LifecycleRule) Validate() error {
+	if r.ExpirationDays == nil && r.NoncurrentVersionExpirationDays == nil {
+		return ErrLifecycleRuleWithoutAction
+	}
+
+	for _, days := range []*int{r.ExpirationDays, r.NoncurrentVersionExpirationDays} {
+		if days != nil && *days <= 0 {
+			return ErrLifecycleRuleInvalidDays
+		}
+	}
+
+	return nil
+}
+
+// AddLifecycleRule validates the given rule against the given Bucket and creates it. The bucket has to be
+// retrieved beforehand, as its versioning setting is checked for rules expiring noncurrent versions.
+func AddLifecycleRule(ctx context.Context, a api.API, bucket *Bucket, rule *LifecycleRule) error {
+	if bucket.Identifier == "" {
+		return types.ErrUnidentifiedObject
+	}
+
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	if rule.NoncurrentVersionExpirationDays != nil && !bucket.VersioningActive {
+		return ErrLifecycleRuleRequiresVersioning
+	}
+
+	rule.Bucket = common.PartialResource{Identifier: bucket.Identifier}
+
+	if err := a.Create(ctx, rule); err != nil {
+		return fmt.Errorf("failed to create lifecycle rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListLifecycleRules retrieves all lifecycle rules of the Bucket identified by bucketID.
+func ListLifecycleRules(ctx context.Context, a api.API, bucketID string) ([]LifecycleRule, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &LifecycleRule{Bucket: common.PartialResource{Identifier: bucketID}}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed to list lifecycle rules: %w", err)
+	}
+
+	rules := make([]LifecycleRule, 0)
+	for retriever := range channel {
+		var rule LifecycleRule
+		if err := retriever(&rule); err != nil {
+			return nil, fmt.Errorf("failed to retrieve lifecycle rule: %w", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
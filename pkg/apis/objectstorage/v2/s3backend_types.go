@@ -26,5 +26,5 @@ type S3Backend struct {
 	BackendType     *GenericAttributeSelect `json:"backend_type,omitempty"`
 	Enabled         *bool                   `json:"enabled,omitempty"`
 	BackendUser     string                  `json:"backend_user,omitempty"`
-	BackendPassword string                  `json:"backend_password,omitempty"`
+	BackendPassword string                  `json:"backend_password,omitempty" anxcloud:"secret"`
 }
@@ -76,6 +76,11 @@ func (t *Tenant) FilterAPIRequest(ctx context.Context, req *http.Request) (*http
 	return req, nil
 }
 
+// SupportsPatch returns true as the Object Storage API accepts PATCH requests containing only the changed fields.
+func (t *Tenant) SupportsPatch(ctx context.Context) bool {
+	return true
+}
+
 // FilterAPIRequestBody generates the request body for Tenants, replacing linked Objects with just their identifier.
 func (t *Tenant) FilterAPIRequestBody(ctx context.Context) (interface{}, error) {
 	op, err := types.OperationFromContext(ctx)
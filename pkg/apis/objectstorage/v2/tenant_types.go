@@ -1,11 +1,13 @@
 package v2
 
 import (
+	"math"
+
 	"go.anx.io/go-anxcloud/pkg/apis/common"
 	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook,PatchHook
 
 // Tenant represents a tenant resource in the Object Storage API.
 type Tenant struct {
@@ -26,8 +28,23 @@ type Tenant struct {
 	RemoteID    *string                `json:"remote_id,omitempty"`
 	Description string                 `json:"description"`
 	UserName    string                 `json:"user"`
-	Password    string                 `json:"password,omitempty"`
+	Password    string                 `json:"password,omitempty" anxcloud:"secret"`
 	Quota       *float64               `json:"quota,omitempty"`
 	Usage       *float64               `json:"usage,omitempty"`
 	Backend     common.PartialResource `json:"backend"`
 }
+
+// QuotaRemaining returns the number of bytes the Tenant can still store in all its buckets before reaching
+// its quota, never less than 0. The second return value is false if the Tenant has no quota configured.
+func (t *Tenant) QuotaRemaining() (float64, bool) {
+	if t.Quota == nil || *t.Quota == 0 {
+		return 0, false
+	}
+
+	var usage float64
+	if t.Usage != nil {
+		usage = *t.Usage
+	}
+
+	return math.Max(*t.Quota-usage, 0), true
+}
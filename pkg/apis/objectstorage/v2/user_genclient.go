@@ -137,3 +137,8 @@ func (user *User) FilterAPIRequest(ctx context.Context, req *http.Request) (*htt
 
 	return req, nil
 }
+
+// SupportsPatch returns true as the Object Storage API accepts PATCH requests containing only the changed fields.
+func (user *User) SupportsPatch(ctx context.Context) bool {
+	return true
+}
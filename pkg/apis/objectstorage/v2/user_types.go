@@ -5,7 +5,7 @@ import (
 	"go.anx.io/go-anxcloud/pkg/apis/common/gs"
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook,PatchHook
 
 // User represents a user resource in the Object Storage API.
 type User struct {
@@ -4,13 +4,31 @@ package v2
 
 import (
 	"context"
+	"maps"
+	"reflect"
+	"slices"
 )
 
+// GetIdentifier returns the primary identifier of a AutomationRuleExecution object
+func (o *AutomationRuleExecution) GetIdentifier(ctx context.Context) (string, error) {
+	return o.ProcessIdentifier, nil
+}
+
+// GetIdentifier returns the primary identifier of a bucketSettings object
+func (o *bucketSettings) GetIdentifier(ctx context.Context) (string, error) {
+	return o.BucketIdentifier, nil
+}
+
 // GetIdentifier returns the primary identifier of a Bucket object
 func (o *Bucket) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
 
+// GetIdentifier returns the primary identifier of a AutomationRuleProcess object
+func (o *AutomationRuleProcess) GetIdentifier(ctx context.Context) (string, error) {
+	return o.Identifier, nil
+}
+
 // GetIdentifier returns the primary identifier of a Endpoint object
 func (o *Endpoint) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
@@ -21,6 +39,11 @@ func (o *Key) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
 
+// GetIdentifier returns the primary identifier of a LifecycleRule object
+func (o *LifecycleRule) GetIdentifier(ctx context.Context) (string, error) {
+	return o.Identifier, nil
+}
+
 // GetIdentifier returns the primary identifier of a Region object
 func (o *Region) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
@@ -40,3 +63,1745 @@ func (o *Tenant) GetIdentifier(ctx context.Context) (string, error) {
 func (o *User) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the AutomationRuleExecution object. Values stored in interface fields are copied shallowly.
+func (o *AutomationRuleExecution) DeepCopy() *AutomationRuleExecution {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+
+	return &out
+}
+
+// Equal returns if the AutomationRuleExecution object is equal to the given one, comparing all fields deeply.
+func (o *AutomationRuleExecution) Equal(other *AutomationRuleExecution) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.ProcessIdentifier != other.ProcessIdentifier {
+		return false
+	}
+	if o.RuleIdentifier != other.RuleIdentifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.ResourceType != other.ResourceType {
+		return false
+	}
+	if o.ResourceIdentifier != other.ResourceIdentifier {
+		return false
+	}
+
+	return true
+}
+
+// AutomationRuleExecutionFields contains the names of the fields of AutomationRuleExecution objects, usable with compare.Compare and compare.Reconcile.
+var AutomationRuleExecutionFields = struct {
+	ProcessIdentifier  string
+	RuleIdentifier     string
+	Name               string
+	ResourceType       string
+	ResourceIdentifier string
+}{
+	ProcessIdentifier:  "ProcessIdentifier",
+	RuleIdentifier:     "RuleIdentifier",
+	Name:               "Name",
+	ResourceType:       "ResourceType",
+	ResourceIdentifier: "ResourceIdentifier",
+}
+
+// DeepCopy returns a deep copy of the Bucket object. Values stored in interface fields are copied shallowly.
+func (o *Bucket) DeepCopy() *Bucket {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	for i1 := range out.AutomationRules {
+		out.AutomationRules[i1].Config = maps.Clone(out.AutomationRules[i1].Config)
+	}
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.ObjectLockLifetime != nil {
+		v1 := *out.ObjectLockLifetime
+		out.ObjectLockLifetime = &v1
+	}
+	out.Embed = slices.Clone(out.Embed)
+
+	return &out
+}
+
+// Equal returns if the Bucket object is equal to the given one, comparing all fields deeply.
+func (o *Bucket) Equal(other *Bucket) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+		if o.AutomationRules[i1].TriggerType != other.AutomationRules[i1].TriggerType {
+			return false
+		}
+		if o.AutomationRules[i1].ProcessingType != other.AutomationRules[i1].ProcessingType {
+			return false
+		}
+		if o.AutomationRules[i1].Enabled != other.AutomationRules[i1].Enabled {
+			return false
+		}
+		if (o.AutomationRules[i1].Config == nil) != (other.AutomationRules[i1].Config == nil) || len(o.AutomationRules[i1].Config) != len(other.AutomationRules[i1].Config) {
+			return false
+		}
+		for k3, va3 := range o.AutomationRules[i1].Config {
+			vb3, ok := other.AutomationRules[i1].Config[k3]
+			if !ok {
+				return false
+			}
+			if va3 != vb3 {
+				return false
+			}
+		}
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Description != other.Description {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if o.Region.Identifier != other.Region.Identifier {
+		return false
+	}
+	if o.Region.Name != other.Region.Name {
+		return false
+	}
+	if !reflect.DeepEqual(o.ObjectCount, other.ObjectCount) {
+		return false
+	}
+	if !reflect.DeepEqual(o.ObjectSize, other.ObjectSize) {
+		return false
+	}
+	if o.Backend.Identifier != other.Backend.Identifier {
+		return false
+	}
+	if o.Backend.Name != other.Backend.Name {
+		return false
+	}
+	if o.Tenant.Identifier != other.Tenant.Identifier {
+		return false
+	}
+	if o.Tenant.Name != other.Tenant.Name {
+		return false
+	}
+	if (o.ObjectLockLifetime == nil) != (other.ObjectLockLifetime == nil) {
+		return false
+	}
+	if o.ObjectLockLifetime != nil {
+		if (*o.ObjectLockLifetime) != (*other.ObjectLockLifetime) {
+			return false
+		}
+	}
+	if o.VersioningActive != other.VersioningActive {
+		return false
+	}
+	if (o.Embed == nil) != (other.Embed == nil) || len(o.Embed) != len(other.Embed) {
+		return false
+	}
+	for i1 := range o.Embed {
+		if o.Embed[i1] != other.Embed[i1] {
+			return false
+		}
+	}
+	if o.Usage.ObjectCount != other.Usage.ObjectCount {
+		return false
+	}
+	if o.Usage.ObjectSize != other.Usage.ObjectSize {
+		return false
+	}
+
+	return true
+}
+
+// BucketFields contains the names of the fields of Bucket objects, usable with compare.Compare and compare.Reconcile.
+var BucketFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	AutomationRules    string
+	Name               string
+	Description        string
+	State              string
+	Region             string
+	ObjectCount        string
+	ObjectSize         string
+	Backend            string
+	Tenant             string
+	ObjectLockLifetime string
+	VersioningActive   string
+	Embed              string
+	Usage              string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	AutomationRules:    "AutomationRules",
+	Name:               "Name",
+	Description:        "Description",
+	State:              "State",
+	Region:             "Region",
+	ObjectCount:        "ObjectCount",
+	ObjectSize:         "ObjectSize",
+	Backend:            "Backend",
+	Tenant:             "Tenant",
+	ObjectLockLifetime: "ObjectLockLifetime",
+	VersioningActive:   "VersioningActive",
+	Embed:              "Embed",
+	Usage:              "Usage",
+}
+
+// DeepCopy returns a deep copy of the AutomationRuleProcess object. Values stored in interface fields are copied shallowly.
+func (o *AutomationRuleProcess) DeepCopy() *AutomationRuleProcess {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Rule.Config = maps.Clone(out.Rule.Config)
+	if out.Message != nil {
+		v1 := *out.Message
+		out.Message = &v1
+	}
+	out.ProcessTasks = slices.Clone(out.ProcessTasks)
+	for i1 := range out.ProcessTasks {
+		if out.ProcessTasks[i1].TaskInfo != nil {
+			v3 := *out.ProcessTasks[i1].TaskInfo
+			if v3.Error != nil {
+				v5 := *v3.Error
+				v3.Error = &v5
+			}
+			if v3.StatusText != nil {
+				v5 := *v3.StatusText
+				v3.StatusText = &v5
+			}
+			if v3.StartedAt != nil {
+				v5 := *v3.StartedAt
+				v3.StartedAt = &v5
+			}
+			if v3.FinishedAt != nil {
+				v5 := *v3.FinishedAt
+				v3.FinishedAt = &v5
+			}
+			out.ProcessTasks[i1].TaskInfo = &v3
+		}
+	}
+	if out.TaskInfo != nil {
+		v1 := *out.TaskInfo
+		if v1.Error != nil {
+			v3 := *v1.Error
+			v1.Error = &v3
+		}
+		if v1.StatusText != nil {
+			v3 := *v1.StatusText
+			v1.StatusText = &v3
+		}
+		if v1.StartedAt != nil {
+			v3 := *v1.StartedAt
+			v1.StartedAt = &v3
+		}
+		if v1.FinishedAt != nil {
+			v3 := *v1.FinishedAt
+			v1.FinishedAt = &v3
+		}
+		out.TaskInfo = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the AutomationRuleProcess object is equal to the given one, comparing all fields deeply.
+func (o *AutomationRuleProcess) Equal(other *AutomationRuleProcess) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Rule.Identifier != other.Rule.Identifier {
+		return false
+	}
+	if o.Rule.Name != other.Rule.Name {
+		return false
+	}
+	if o.Rule.TriggerType != other.Rule.TriggerType {
+		return false
+	}
+	if o.Rule.ProcessingType != other.Rule.ProcessingType {
+		return false
+	}
+	if o.Rule.Enabled != other.Rule.Enabled {
+		return false
+	}
+	if (o.Rule.Config == nil) != (other.Rule.Config == nil) || len(o.Rule.Config) != len(other.Rule.Config) {
+		return false
+	}
+	for k2, va2 := range o.Rule.Config {
+		vb2, ok := other.Rule.Config[k2]
+		if !ok {
+			return false
+		}
+		if va2 != vb2 {
+			return false
+		}
+	}
+	if o.Status.StatusCode != other.Status.StatusCode {
+		return false
+	}
+	if o.Status.StatusType != other.Status.StatusType {
+		return false
+	}
+	if (o.Message == nil) != (other.Message == nil) {
+		return false
+	}
+	if o.Message != nil {
+		if (*o.Message) != (*other.Message) {
+			return false
+		}
+	}
+	if o.Progress != other.Progress {
+		return false
+	}
+	if o.CreatedAt != other.CreatedAt {
+		return false
+	}
+	if o.UpdatedAt != other.UpdatedAt {
+		return false
+	}
+	if (o.ProcessTasks == nil) != (other.ProcessTasks == nil) || len(o.ProcessTasks) != len(other.ProcessTasks) {
+		return false
+	}
+	for i1 := range o.ProcessTasks {
+		if o.ProcessTasks[i1].Identifier != other.ProcessTasks[i1].Identifier {
+			return false
+		}
+		if o.ProcessTasks[i1].Name != other.ProcessTasks[i1].Name {
+			return false
+		}
+		if o.ProcessTasks[i1].Status.StatusCode != other.ProcessTasks[i1].Status.StatusCode {
+			return false
+		}
+		if o.ProcessTasks[i1].Status.StatusType != other.ProcessTasks[i1].Status.StatusType {
+			return false
+		}
+		if (o.ProcessTasks[i1].TaskInfo == nil) != (other.ProcessTasks[i1].TaskInfo == nil) {
+			return false
+		}
+		if o.ProcessTasks[i1].TaskInfo != nil {
+			if (*o.ProcessTasks[i1].TaskInfo).Type != (*other.ProcessTasks[i1].TaskInfo).Type {
+				return false
+			}
+			if !reflect.DeepEqual((*o.ProcessTasks[i1].TaskInfo).Config, (*other.ProcessTasks[i1].TaskInfo).Config) {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).ID != (*other.ProcessTasks[i1].TaskInfo).ID {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).Progress != (*other.ProcessTasks[i1].TaskInfo).Progress {
+				return false
+			}
+			if ((*o.ProcessTasks[i1].TaskInfo).Error == nil) != ((*other.ProcessTasks[i1].TaskInfo).Error == nil) {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).Error != nil {
+				if (*(*o.ProcessTasks[i1].TaskInfo).Error) != (*(*other.ProcessTasks[i1].TaskInfo).Error) {
+					return false
+				}
+			}
+			if ((*o.ProcessTasks[i1].TaskInfo).StatusText == nil) != ((*other.ProcessTasks[i1].TaskInfo).StatusText == nil) {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).StatusText != nil {
+				if (*(*o.ProcessTasks[i1].TaskInfo).StatusText) != (*(*other.ProcessTasks[i1].TaskInfo).StatusText) {
+					return false
+				}
+			}
+			if ((*o.ProcessTasks[i1].TaskInfo).StartedAt == nil) != ((*other.ProcessTasks[i1].TaskInfo).StartedAt == nil) {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).StartedAt != nil {
+				if (*(*o.ProcessTasks[i1].TaskInfo).StartedAt) != (*(*other.ProcessTasks[i1].TaskInfo).StartedAt) {
+					return false
+				}
+			}
+			if ((*o.ProcessTasks[i1].TaskInfo).FinishedAt == nil) != ((*other.ProcessTasks[i1].TaskInfo).FinishedAt == nil) {
+				return false
+			}
+			if (*o.ProcessTasks[i1].TaskInfo).FinishedAt != nil {
+				if (*(*o.ProcessTasks[i1].TaskInfo).FinishedAt) != (*(*other.ProcessTasks[i1].TaskInfo).FinishedAt) {
+					return false
+				}
+			}
+		}
+	}
+	if (o.TaskInfo == nil) != (other.TaskInfo == nil) {
+		return false
+	}
+	if o.TaskInfo != nil {
+		if (*o.TaskInfo).Type != (*other.TaskInfo).Type {
+			return false
+		}
+		if !reflect.DeepEqual((*o.TaskInfo).Config, (*other.TaskInfo).Config) {
+			return false
+		}
+		if (*o.TaskInfo).ID != (*other.TaskInfo).ID {
+			return false
+		}
+		if (*o.TaskInfo).Progress != (*other.TaskInfo).Progress {
+			return false
+		}
+		if ((*o.TaskInfo).Error == nil) != ((*other.TaskInfo).Error == nil) {
+			return false
+		}
+		if (*o.TaskInfo).Error != nil {
+			if (*(*o.TaskInfo).Error) != (*(*other.TaskInfo).Error) {
+				return false
+			}
+		}
+		if ((*o.TaskInfo).StatusText == nil) != ((*other.TaskInfo).StatusText == nil) {
+			return false
+		}
+		if (*o.TaskInfo).StatusText != nil {
+			if (*(*o.TaskInfo).StatusText) != (*(*other.TaskInfo).StatusText) {
+				return false
+			}
+		}
+		if ((*o.TaskInfo).StartedAt == nil) != ((*other.TaskInfo).StartedAt == nil) {
+			return false
+		}
+		if (*o.TaskInfo).StartedAt != nil {
+			if (*(*o.TaskInfo).StartedAt) != (*(*other.TaskInfo).StartedAt) {
+				return false
+			}
+		}
+		if ((*o.TaskInfo).FinishedAt == nil) != ((*other.TaskInfo).FinishedAt == nil) {
+			return false
+		}
+		if (*o.TaskInfo).FinishedAt != nil {
+			if (*(*o.TaskInfo).FinishedAt) != (*(*other.TaskInfo).FinishedAt) {
+				return false
+			}
+		}
+	}
+	if o.ResourceReference.Identifier != other.ResourceReference.Identifier {
+		return false
+	}
+	if o.ResourceReference.Name != other.ResourceReference.Name {
+		return false
+	}
+	if o.ResourceType != other.ResourceType {
+		return false
+	}
+
+	return true
+}
+
+// AutomationRuleProcessFields contains the names of the fields of AutomationRuleProcess objects, usable with compare.Compare and compare.Reconcile.
+var AutomationRuleProcessFields = struct {
+	Identifier        string
+	Rule              string
+	Status            string
+	Message           string
+	Progress          string
+	CreatedAt         string
+	UpdatedAt         string
+	ProcessTasks      string
+	TaskInfo          string
+	ResourceReference string
+	ResourceType      string
+}{
+	Identifier:        "Identifier",
+	Rule:              "Rule",
+	Status:            "Status",
+	Message:           "Message",
+	Progress:          "Progress",
+	CreatedAt:         "CreatedAt",
+	UpdatedAt:         "UpdatedAt",
+	ProcessTasks:      "ProcessTasks",
+	TaskInfo:          "TaskInfo",
+	ResourceReference: "ResourceReference",
+	ResourceType:      "ResourceType",
+}
+
+// DeepCopy returns a deep copy of the Endpoint object. Values stored in interface fields are copied shallowly.
+func (o *Endpoint) DeepCopy() *Endpoint {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	for i1 := range out.AutomationRules {
+		out.AutomationRules[i1].Config = maps.Clone(out.AutomationRules[i1].Config)
+	}
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Endpoint object is equal to the given one, comparing all fields deeply.
+func (o *Endpoint) Equal(other *Endpoint) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+		if o.AutomationRules[i1].TriggerType != other.AutomationRules[i1].TriggerType {
+			return false
+		}
+		if o.AutomationRules[i1].ProcessingType != other.AutomationRules[i1].ProcessingType {
+			return false
+		}
+		if o.AutomationRules[i1].Enabled != other.AutomationRules[i1].Enabled {
+			return false
+		}
+		if (o.AutomationRules[i1].Config == nil) != (other.AutomationRules[i1].Config == nil) || len(o.AutomationRules[i1].Config) != len(other.AutomationRules[i1].Config) {
+			return false
+		}
+		for k3, va3 := range o.AutomationRules[i1].Config {
+			vb3, ok := other.AutomationRules[i1].Config[k3]
+			if !ok {
+				return false
+			}
+			if va3 != vb3 {
+				return false
+			}
+		}
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.URL != other.URL {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if o.EndpointUser != other.EndpointUser {
+		return false
+	}
+	if o.EndpointPassword != other.EndpointPassword {
+		return false
+	}
+	if o.Enabled != other.Enabled {
+		return false
+	}
+
+	return true
+}
+
+// EndpointFields contains the names of the fields of Endpoint objects, usable with compare.Compare and compare.Reconcile.
+var EndpointFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	AutomationRules    string
+	Name               string
+	URL                string
+	State              string
+	EndpointUser       string
+	EndpointPassword   string
+	Enabled            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	AutomationRules:    "AutomationRules",
+	Name:               "Name",
+	URL:                "URL",
+	State:              "State",
+	EndpointUser:       "EndpointUser",
+	EndpointPassword:   "EndpointPassword",
+	Enabled:            "Enabled",
+}
+
+// DeepCopy returns a deep copy of the Key object. Values stored in interface fields are copied shallowly.
+func (o *Key) DeepCopy() *Key {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	if out.RemoteID != nil {
+		v1 := *out.RemoteID
+		out.RemoteID = &v1
+	}
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.Backend != nil {
+		v1 := *out.Backend
+		out.Backend = &v1
+	}
+	if out.Tenant != nil {
+		v1 := *out.Tenant
+		out.Tenant = &v1
+	}
+	if out.User != nil {
+		v1 := *out.User
+		out.User = &v1
+	}
+	if out.ExpireDate != nil {
+		v1 := *out.ExpireDate
+		out.ExpireDate = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Key object is equal to the given one, comparing all fields deeply.
+func (o *Key) Equal(other *Key) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if (o.RemoteID == nil) != (other.RemoteID == nil) {
+		return false
+	}
+	if o.RemoteID != nil {
+		if (*o.RemoteID) != (*other.RemoteID) {
+			return false
+		}
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if (o.Backend == nil) != (other.Backend == nil) {
+		return false
+	}
+	if o.Backend != nil {
+		if (*o.Backend).Identifier != (*other.Backend).Identifier {
+			return false
+		}
+		if (*o.Backend).Name != (*other.Backend).Name {
+			return false
+		}
+	}
+	if (o.Tenant == nil) != (other.Tenant == nil) {
+		return false
+	}
+	if o.Tenant != nil {
+		if (*o.Tenant).Identifier != (*other.Tenant).Identifier {
+			return false
+		}
+		if (*o.Tenant).Name != (*other.Tenant).Name {
+			return false
+		}
+	}
+	if (o.User == nil) != (other.User == nil) {
+		return false
+	}
+	if o.User != nil {
+		if (*o.User).Identifier != (*other.User).Identifier {
+			return false
+		}
+		if (*o.User).Name != (*other.User).Name {
+			return false
+		}
+	}
+	if (o.ExpireDate == nil) != (other.ExpireDate == nil) {
+		return false
+	}
+	if o.ExpireDate != nil {
+		if !(*o.ExpireDate).Equal((*other.ExpireDate)) {
+			return false
+		}
+	}
+	if o.SecretURL != other.SecretURL {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+
+	return true
+}
+
+// KeyFields contains the names of the fields of Key objects, usable with compare.Compare and compare.Reconcile.
+var KeyFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	RemoteID           string
+	State              string
+	Backend            string
+	Tenant             string
+	User               string
+	ExpireDate         string
+	SecretURL          string
+	Name               string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	RemoteID:           "RemoteID",
+	State:              "State",
+	Backend:            "Backend",
+	Tenant:             "Tenant",
+	User:               "User",
+	ExpireDate:         "ExpireDate",
+	SecretURL:          "SecretURL",
+	Name:               "Name",
+}
+
+// DeepCopy returns a deep copy of the LifecycleRule object. Values stored in interface fields are copied shallowly.
+func (o *LifecycleRule) DeepCopy() *LifecycleRule {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	for i1 := range out.AutomationRules {
+		out.AutomationRules[i1].Config = maps.Clone(out.AutomationRules[i1].Config)
+	}
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.Enabled != nil {
+		v1 := *out.Enabled
+		out.Enabled = &v1
+	}
+	if out.ExpirationDays != nil {
+		v1 := *out.ExpirationDays
+		out.ExpirationDays = &v1
+	}
+	if out.NoncurrentVersionExpirationDays != nil {
+		v1 := *out.NoncurrentVersionExpirationDays
+		out.NoncurrentVersionExpirationDays = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the LifecycleRule object is equal to the given one, comparing all fields deeply.
+func (o *LifecycleRule) Equal(other *LifecycleRule) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+		if o.AutomationRules[i1].TriggerType != other.AutomationRules[i1].TriggerType {
+			return false
+		}
+		if o.AutomationRules[i1].ProcessingType != other.AutomationRules[i1].ProcessingType {
+			return false
+		}
+		if o.AutomationRules[i1].Enabled != other.AutomationRules[i1].Enabled {
+			return false
+		}
+		if (o.AutomationRules[i1].Config == nil) != (other.AutomationRules[i1].Config == nil) || len(o.AutomationRules[i1].Config) != len(other.AutomationRules[i1].Config) {
+			return false
+		}
+		for k3, va3 := range o.AutomationRules[i1].Config {
+			vb3, ok := other.AutomationRules[i1].Config[k3]
+			if !ok {
+				return false
+			}
+			if va3 != vb3 {
+				return false
+			}
+		}
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if o.Bucket.Identifier != other.Bucket.Identifier {
+		return false
+	}
+	if o.Bucket.Name != other.Bucket.Name {
+		return false
+	}
+	if o.Prefix != other.Prefix {
+		return false
+	}
+	if (o.Enabled == nil) != (other.Enabled == nil) {
+		return false
+	}
+	if o.Enabled != nil {
+		if (*o.Enabled) != (*other.Enabled) {
+			return false
+		}
+	}
+	if (o.ExpirationDays == nil) != (other.ExpirationDays == nil) {
+		return false
+	}
+	if o.ExpirationDays != nil {
+		if (*o.ExpirationDays) != (*other.ExpirationDays) {
+			return false
+		}
+	}
+	if (o.NoncurrentVersionExpirationDays == nil) != (other.NoncurrentVersionExpirationDays == nil) {
+		return false
+	}
+	if o.NoncurrentVersionExpirationDays != nil {
+		if (*o.NoncurrentVersionExpirationDays) != (*other.NoncurrentVersionExpirationDays) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LifecycleRuleFields contains the names of the fields of LifecycleRule objects, usable with compare.Compare and compare.Reconcile.
+var LifecycleRuleFields = struct {
+	GenericService                  string
+	HasState                        string
+	CustomerIdentifier              string
+	ResellerIdentifier              string
+	Identifier                      string
+	Reseller                        string
+	Customer                        string
+	AutomationRules                 string
+	Name                            string
+	State                           string
+	Bucket                          string
+	Prefix                          string
+	Enabled                         string
+	ExpirationDays                  string
+	NoncurrentVersionExpirationDays string
+}{
+	GenericService:                  "GenericService",
+	HasState:                        "HasState",
+	CustomerIdentifier:              "CustomerIdentifier",
+	ResellerIdentifier:              "ResellerIdentifier",
+	Identifier:                      "Identifier",
+	Reseller:                        "Reseller",
+	Customer:                        "Customer",
+	AutomationRules:                 "AutomationRules",
+	Name:                            "Name",
+	State:                           "State",
+	Bucket:                          "Bucket",
+	Prefix:                          "Prefix",
+	Enabled:                         "Enabled",
+	ExpirationDays:                  "ExpirationDays",
+	NoncurrentVersionExpirationDays: "NoncurrentVersionExpirationDays",
+}
+
+// DeepCopy returns a deep copy of the Region object. Values stored in interface fields are copied shallowly.
+func (o *Region) DeepCopy() *Region {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.Backend != nil {
+		v1 := *out.Backend
+		out.Backend = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Region object is equal to the given one, comparing all fields deeply.
+func (o *Region) Equal(other *Region) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if o.Description != other.Description {
+		return false
+	}
+	if (o.Backend == nil) != (other.Backend == nil) {
+		return false
+	}
+	if o.Backend != nil {
+		if (*o.Backend).Identifier != (*other.Backend).Identifier {
+			return false
+		}
+		if (*o.Backend).Name != (*other.Backend).Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RegionFields contains the names of the fields of Region objects, usable with compare.Compare and compare.Reconcile.
+var RegionFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	Name               string
+	State              string
+	Description        string
+	Backend            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	Name:               "Name",
+	State:              "State",
+	Description:        "Description",
+	Backend:            "Backend",
+}
+
+// DeepCopy returns a deep copy of the S3Backend object. Values stored in interface fields are copied shallowly.
+func (o *S3Backend) DeepCopy() *S3Backend {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.BackendType != nil {
+		v1 := *out.BackendType
+		out.BackendType = &v1
+	}
+	if out.Enabled != nil {
+		v1 := *out.Enabled
+		out.Enabled = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the S3Backend object is equal to the given one, comparing all fields deeply.
+func (o *S3Backend) Equal(other *S3Backend) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if o.Endpoint.Identifier != other.Endpoint.Identifier {
+		return false
+	}
+	if o.Endpoint.Name != other.Endpoint.Name {
+		return false
+	}
+	if (o.BackendType == nil) != (other.BackendType == nil) {
+		return false
+	}
+	if o.BackendType != nil {
+		if (*o.BackendType).Identifier != (*other.BackendType).Identifier {
+			return false
+		}
+		if (*o.BackendType).Name != (*other.BackendType).Name {
+			return false
+		}
+	}
+	if (o.Enabled == nil) != (other.Enabled == nil) {
+		return false
+	}
+	if o.Enabled != nil {
+		if (*o.Enabled) != (*other.Enabled) {
+			return false
+		}
+	}
+	if o.BackendUser != other.BackendUser {
+		return false
+	}
+	if o.BackendPassword != other.BackendPassword {
+		return false
+	}
+
+	return true
+}
+
+// S3BackendFields contains the names of the fields of S3Backend objects, usable with compare.Compare and compare.Reconcile.
+var S3BackendFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	Name               string
+	State              string
+	Endpoint           string
+	BackendType        string
+	Enabled            string
+	BackendUser        string
+	BackendPassword    string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	Name:               "Name",
+	State:              "State",
+	Endpoint:           "Endpoint",
+	BackendType:        "BackendType",
+	Enabled:            "Enabled",
+	BackendUser:        "BackendUser",
+	BackendPassword:    "BackendPassword",
+}
+
+// DeepCopy returns a deep copy of the Tenant object. Values stored in interface fields are copied shallowly.
+func (o *Tenant) DeepCopy() *Tenant {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	out.AutomationRules = slices.Clone(out.AutomationRules)
+	for i1 := range out.AutomationRules {
+		out.AutomationRules[i1].Config = maps.Clone(out.AutomationRules[i1].Config)
+	}
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.RemoteID != nil {
+		v1 := *out.RemoteID
+		out.RemoteID = &v1
+	}
+	if out.Quota != nil {
+		v1 := *out.Quota
+		out.Quota = &v1
+	}
+	if out.Usage != nil {
+		v1 := *out.Usage
+		out.Usage = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the Tenant object is equal to the given one, comparing all fields deeply.
+func (o *Tenant) Equal(other *Tenant) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if (o.AutomationRules == nil) != (other.AutomationRules == nil) || len(o.AutomationRules) != len(other.AutomationRules) {
+		return false
+	}
+	for i1 := range o.AutomationRules {
+		if o.AutomationRules[i1].Identifier != other.AutomationRules[i1].Identifier {
+			return false
+		}
+		if o.AutomationRules[i1].Name != other.AutomationRules[i1].Name {
+			return false
+		}
+		if o.AutomationRules[i1].TriggerType != other.AutomationRules[i1].TriggerType {
+			return false
+		}
+		if o.AutomationRules[i1].ProcessingType != other.AutomationRules[i1].ProcessingType {
+			return false
+		}
+		if o.AutomationRules[i1].Enabled != other.AutomationRules[i1].Enabled {
+			return false
+		}
+		if (o.AutomationRules[i1].Config == nil) != (other.AutomationRules[i1].Config == nil) || len(o.AutomationRules[i1].Config) != len(other.AutomationRules[i1].Config) {
+			return false
+		}
+		for k3, va3 := range o.AutomationRules[i1].Config {
+			vb3, ok := other.AutomationRules[i1].Config[k3]
+			if !ok {
+				return false
+			}
+			if va3 != vb3 {
+				return false
+			}
+		}
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if (o.RemoteID == nil) != (other.RemoteID == nil) {
+		return false
+	}
+	if o.RemoteID != nil {
+		if (*o.RemoteID) != (*other.RemoteID) {
+			return false
+		}
+	}
+	if o.Description != other.Description {
+		return false
+	}
+	if o.UserName != other.UserName {
+		return false
+	}
+	if o.Password != other.Password {
+		return false
+	}
+	if (o.Quota == nil) != (other.Quota == nil) {
+		return false
+	}
+	if o.Quota != nil {
+		if (*o.Quota) != (*other.Quota) {
+			return false
+		}
+	}
+	if (o.Usage == nil) != (other.Usage == nil) {
+		return false
+	}
+	if o.Usage != nil {
+		if (*o.Usage) != (*other.Usage) {
+			return false
+		}
+	}
+	if o.Backend.Identifier != other.Backend.Identifier {
+		return false
+	}
+	if o.Backend.Name != other.Backend.Name {
+		return false
+	}
+
+	return true
+}
+
+// TenantFields contains the names of the fields of Tenant objects, usable with compare.Compare and compare.Reconcile.
+var TenantFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	AutomationRules    string
+	Name               string
+	State              string
+	RemoteID           string
+	Description        string
+	UserName           string
+	Password           string
+	Quota              string
+	Usage              string
+	Backend            string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	AutomationRules:    "AutomationRules",
+	Name:               "Name",
+	State:              "State",
+	RemoteID:           "RemoteID",
+	Description:        "Description",
+	UserName:           "UserName",
+	Password:           "Password",
+	Quota:              "Quota",
+	Usage:              "Usage",
+	Backend:            "Backend",
+}
+
+// DeepCopy returns a deep copy of the User object. Values stored in interface fields are copied shallowly.
+func (o *User) DeepCopy() *User {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Tags = slices.Clone(out.Tags)
+	if out.State != nil {
+		v1 := *out.State
+		out.State = &v1
+	}
+	if out.Enabled != nil {
+		v1 := *out.Enabled
+		out.Enabled = &v1
+	}
+	if out.RemoteID != nil {
+		v1 := *out.RemoteID
+		out.RemoteID = &v1
+	}
+
+	return &out
+}
+
+// Equal returns if the User object is equal to the given one, comparing all fields deeply.
+func (o *User) Equal(other *User) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.HasState.State.ID != other.HasState.State.ID {
+		return false
+	}
+	if o.HasState.State.Text != other.HasState.State.Text {
+		return false
+	}
+	if o.HasState.State.Type != other.HasState.State.Type {
+		return false
+	}
+	if o.CustomerIdentifier != other.CustomerIdentifier {
+		return false
+	}
+	if o.ResellerIdentifier != other.ResellerIdentifier {
+		return false
+	}
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if (o.Tags == nil) != (other.Tags == nil) || len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i1 := range o.Tags {
+		if o.Tags[i1].Identifier != other.Tags[i1].Identifier {
+			return false
+		}
+		if o.Tags[i1].Name != other.Tags[i1].Name {
+			return false
+		}
+	}
+	if o.Reseller != other.Reseller {
+		return false
+	}
+	if o.Customer != other.Customer {
+		return false
+	}
+	if o.Share != other.Share {
+		return false
+	}
+	if o.UserName != other.UserName {
+		return false
+	}
+	if (o.State == nil) != (other.State == nil) {
+		return false
+	}
+	if o.State != nil {
+		if (*o.State).Type != (*other.State).Type {
+			return false
+		}
+		if (*o.State).ID != (*other.State).ID {
+			return false
+		}
+		if (*o.State).Title != (*other.State).Title {
+			return false
+		}
+	}
+	if (o.Enabled == nil) != (other.Enabled == nil) {
+		return false
+	}
+	if o.Enabled != nil {
+		if (*o.Enabled) != (*other.Enabled) {
+			return false
+		}
+	}
+	if o.FullName != other.FullName {
+		return false
+	}
+	if o.Backend.Identifier != other.Backend.Identifier {
+		return false
+	}
+	if o.Backend.Name != other.Backend.Name {
+		return false
+	}
+	if o.Tenant.Identifier != other.Tenant.Identifier {
+		return false
+	}
+	if o.Tenant.Name != other.Tenant.Name {
+		return false
+	}
+	if (o.RemoteID == nil) != (other.RemoteID == nil) {
+		return false
+	}
+	if o.RemoteID != nil {
+		if (*o.RemoteID) != (*other.RemoteID) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UserFields contains the names of the fields of User objects, usable with compare.Compare and compare.Reconcile.
+var UserFields = struct {
+	GenericService     string
+	HasState           string
+	CustomerIdentifier string
+	ResellerIdentifier string
+	Identifier         string
+	Tags               string
+	Reseller           string
+	Customer           string
+	Share              string
+	UserName           string
+	State              string
+	Enabled            string
+	FullName           string
+	Backend            string
+	Tenant             string
+	RemoteID           string
+}{
+	GenericService:     "GenericService",
+	HasState:           "HasState",
+	CustomerIdentifier: "CustomerIdentifier",
+	ResellerIdentifier: "ResellerIdentifier",
+	Identifier:         "Identifier",
+	Tags:               "Tags",
+	Reseller:           "Reseller",
+	Customer:           "Customer",
+	Share:              "Share",
+	UserName:           "UserName",
+	State:              "State",
+	Enabled:            "Enabled",
+	FullName:           "FullName",
+	Backend:            "Backend",
+	Tenant:             "Tenant",
+	RemoteID:           "RemoteID",
+}
@@ -10,10 +10,32 @@ import (
 	apipkg "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
 )
 
+var _ = Describe("Object AutomationRuleExecution", func() {
+	o := apipkg.AutomationRuleExecution{}
+
+	ifaces := make([]interface{}, 0, 3)
+	{
+		var i types.Object
+		ifaces = append(ifaces, &i)
+	}
+	{
+		var i types.ResponseFilterHook
+		ifaces = append(ifaces, &i)
+	}
+	{
+		var i types.PaginationSupportHook
+		ifaces = append(ifaces, &i)
+	}
+
+	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.AutomationRuleExecutionFields)
+})
+
 var _ = Describe("Object Bucket", func() {
 	o := apipkg.Bucket{}
 
-	ifaces := make([]interface{}, 0, 2)
+	ifaces := make([]interface{}, 0, 4)
 	{
 		var i types.Object
 		ifaces = append(ifaces, &i)
@@ -22,8 +44,33 @@ var _ = Describe("Object Bucket", func() {
 		var i types.RequestBodyHook
 		ifaces = append(ifaces, &i)
 	}
+	{
+		var i types.ResponseDecodeHook
+		ifaces = append(ifaces, &i)
+	}
+	{
+		var i types.PatchHook
+		ifaces = append(ifaces, &i)
+	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.BucketFields)
+	testutils.ContractTests(&o, bucketContractOptions()...)
+})
+
+var _ = Describe("Object AutomationRuleProcess", func() {
+	o := apipkg.AutomationRuleProcess{}
+
+	ifaces := make([]interface{}, 0, 1)
+	{
+		var i types.Object
+		ifaces = append(ifaces, &i)
+	}
+
+	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.AutomationRuleProcessFields)
 })
 
 var _ = Describe("Object Endpoint", func() {
@@ -40,12 +87,15 @@ var _ = Describe("Object Endpoint", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.EndpointFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Key", func() {
 	o := apipkg.Key{}
 
-	ifaces := make([]interface{}, 0, 2)
+	ifaces := make([]interface{}, 0, 3)
 	{
 		var i types.Object
 		ifaces = append(ifaces, &i)
@@ -54,8 +104,38 @@ var _ = Describe("Object Key", func() {
 		var i types.RequestBodyHook
 		ifaces = append(ifaces, &i)
 	}
+	{
+		var i types.PatchHook
+		ifaces = append(ifaces, &i)
+	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.KeyFields)
+	testutils.ContractTests(&o)
+})
+
+var _ = Describe("Object LifecycleRule", func() {
+	o := apipkg.LifecycleRule{}
+
+	ifaces := make([]interface{}, 0, 3)
+	{
+		var i types.Object
+		ifaces = append(ifaces, &i)
+	}
+	{
+		var i types.RequestBodyHook
+		ifaces = append(ifaces, &i)
+	}
+	{
+		var i types.PatchHook
+		ifaces = append(ifaces, &i)
+	}
+
+	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.LifecycleRuleFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Region", func() {
@@ -72,6 +152,9 @@ var _ = Describe("Object Region", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.RegionFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object S3Backend", func() {
@@ -88,12 +171,15 @@ var _ = Describe("Object S3Backend", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.S3BackendFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object Tenant", func() {
 	o := apipkg.Tenant{}
 
-	ifaces := make([]interface{}, 0, 2)
+	ifaces := make([]interface{}, 0, 3)
 	{
 		var i types.Object
 		ifaces = append(ifaces, &i)
@@ -102,14 +188,21 @@ var _ = Describe("Object Tenant", func() {
 		var i types.RequestBodyHook
 		ifaces = append(ifaces, &i)
 	}
+	{
+		var i types.PatchHook
+		ifaces = append(ifaces, &i)
+	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.TenantFields)
+	testutils.ContractTests(&o)
 })
 
 var _ = Describe("Object User", func() {
 	o := apipkg.User{}
 
-	ifaces := make([]interface{}, 0, 2)
+	ifaces := make([]interface{}, 0, 3)
 	{
 		var i types.Object
 		ifaces = append(ifaces, &i)
@@ -118,6 +211,13 @@ var _ = Describe("Object User", func() {
 		var i types.RequestBodyHook
 		ifaces = append(ifaces, &i)
 	}
+	{
+		var i types.PatchHook
+		ifaces = append(ifaces, &i)
+	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.UserFields)
+	testutils.ContractTests(&o)
 })
@@ -2,7 +2,6 @@ package v1
 
 import (
 	"context"
-	"fmt"
 	"net/url"
 
 	apiTypes "go.anx.io/go-anxcloud/pkg/api/types"
@@ -43,12 +42,9 @@ func (v *VLAN) FilterAPIRequestBody(ctx context.Context) (interface{}, error) {
 		return nil, err
 	}
 
-	// Creating a VLAN is done with a single location only, despite the API returning an array.
-	if op == apiTypes.OperationCreate {
-		if len(v.Locations) != 1 {
-			return nil, fmt.Errorf("%w: %v locations given", ErrLocationCount, len(v.Locations))
-		}
-
+	// Creating a VLAN is done with a single location only, despite the API returning an array. The number of
+	// locations is checked by Validate.
+	if op == apiTypes.OperationCreate && len(v.Locations) > 0 {
 		data := struct {
 			VLAN
 			Location string `json:"location"`
@@ -34,7 +34,7 @@ var (
 	ErrLocationCount = errors.New("VLANs have to be created with exactly one Location")
 )
 
-// anxcloud:object:hooks=RequestBodyHook
+// anxcloud:object:hooks=RequestBodyHook:resourcetype=cf8e4dac56894afaa3244f6911bb62be
 
 // VLAN describes a virtual network IP prefixes, virtual machines (if VMProvisioning is true) and
 // alike can be deployed into.
@@ -49,5 +49,5 @@ type VLAN struct {
 	// The API returns an array of locations, but there is no way to configure more than one location via the API.
 	// Additionally, not even the one location can be updated via API.
 	// When creating a VLAN pass a single Location object, only the Identifier needs to be set on it.
-	Locations []corev1.Location `json:"locations,omitempty" anxcloud:"filterable"`
+	Locations []corev1.Location `json:"locations,omitempty" anxcloud:"filterable" validate:"create,min=1,max=1,err=ErrLocationCount"`
 }
@@ -83,26 +83,28 @@ var _ = Describe("VLAN Object", func() {
 		),
 	)
 
-	DescribeTable("FilterAPIRequest",
+	DescribeTable("Create",
 		func(locations []corev1.Location, expErr error) {
 			ctx := types.ContextWithOperation(context.TODO(), types.OperationCreate)
 
 			vlan := VLAN{Locations: locations}
 
-			data, err := vlan.FilterAPIRequestBody(ctx)
-
+			err := vlan.Validate(ctx, types.OperationCreate)
 			if expErr != nil {
 				Expect(err).To(MatchError(expErr))
-				Expect(data).To(BeNil())
-			} else {
-				Expect(err).NotTo(HaveOccurred())
-				Expect(data).NotTo(BeNil())
+				return
+			}
 
-				rval := reflect.ValueOf(data)
-				locationIdentifier := rval.FieldByName("Location").Interface().(string)
+			Expect(err).NotTo(HaveOccurred())
 
-				Expect(locationIdentifier).To(Equal(locations[0].Identifier))
-			}
+			data, err := vlan.FilterAPIRequestBody(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).NotTo(BeNil())
+
+			rval := reflect.ValueOf(data)
+			locationIdentifier := rval.FieldByName("Location").Interface().(string)
+
+			Expect(locationIdentifier).To(Equal(locations[0].Identifier))
 		},
 		Entry(
 			"errors without any location",
@@ -4,9 +4,88 @@ package v1
 
 import (
 	"context"
+	"slices"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
 )
 
 // GetIdentifier returns the primary identifier of a VLAN object
 func (o *VLAN) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+func init() {
+	types.RegisterResourceType("cf8e4dac56894afaa3244f6911bb62be", func(identifier, name string) types.Object {
+		return &VLAN{Identifier: identifier}
+	})
+}
+
+// DeepCopy returns a deep copy of the VLAN object. Values stored in interface fields are copied shallowly.
+func (o *VLAN) DeepCopy() *VLAN {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Locations = slices.Clone(out.Locations)
+	for i1 := range out.Locations {
+		out.Locations[i1] = *out.Locations[i1].DeepCopy()
+	}
+
+	return &out
+}
+
+// Equal returns if the VLAN object is equal to the given one, comparing all fields deeply.
+func (o *VLAN) Equal(other *VLAN) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.DescriptionCustomer != other.DescriptionCustomer {
+		return false
+	}
+	if o.RoleText != other.RoleText {
+		return false
+	}
+	if o.Status != other.Status {
+		return false
+	}
+	if o.VMProvisioning != other.VMProvisioning {
+		return false
+	}
+	if (o.Locations == nil) != (other.Locations == nil) || len(o.Locations) != len(other.Locations) {
+		return false
+	}
+	for i1 := range o.Locations {
+		if !o.Locations[i1].Equal(&other.Locations[i1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VLANFields contains the names of the fields of VLAN objects, usable with compare.Compare and compare.Reconcile.
+var VLANFields = struct {
+	Identifier          string
+	Name                string
+	DescriptionCustomer string
+	RoleText            string
+	Status              string
+	VMProvisioning      string
+	Locations           string
+}{
+	Identifier:          "Identifier",
+	Name:                "Name",
+	DescriptionCustomer: "DescriptionCustomer",
+	RoleText:            "RoleText",
+	Status:              "Status",
+	VMProvisioning:      "VMProvisioning",
+	Locations:           "Locations",
+}
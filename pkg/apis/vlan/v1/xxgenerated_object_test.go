@@ -24,4 +24,7 @@ var _ = Describe("Object VLAN", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.VLANFields)
+	testutils.ContractTests(&o)
 })
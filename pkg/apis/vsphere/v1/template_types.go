@@ -13,7 +13,7 @@ var (
 	ErrFailedToParseTemplateBuildNumber = errors.New("failed to parse template build number")
 )
 
-// anxcloud:object:hooks=PaginationSupportHook,FilterRequestURLHook,ResponseDecodeHook
+// anxcloud:object:hooks=PaginationSupportHook,FilterRequestURLHook,ResponseDecodeHook:nocontract
 
 // Template represents a vSphere template used for vm provisioning
 type Template struct {
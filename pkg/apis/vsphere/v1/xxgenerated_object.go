@@ -10,3 +10,60 @@ import (
 func (o *Template) GetIdentifier(ctx context.Context) (string, error) {
 	return o.Identifier, nil
 }
+
+// DeepCopy returns a deep copy of the Template object. Values stored in interface fields are copied shallowly.
+func (o *Template) DeepCopy() *Template {
+	if o == nil {
+		return nil
+	}
+
+	out := *o
+	out.Location = *out.Location.DeepCopy()
+
+	return &out
+}
+
+// Equal returns if the Template object is equal to the given one, comparing all fields deeply.
+func (o *Template) Equal(other *Template) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	if o.Identifier != other.Identifier {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Bit != other.Bit {
+		return false
+	}
+	if o.Build != other.Build {
+		return false
+	}
+	if !o.Location.Equal(&other.Location) {
+		return false
+	}
+	if o.Type != other.Type {
+		return false
+	}
+
+	return true
+}
+
+// TemplateFields contains the names of the fields of Template objects, usable with compare.Compare and compare.Reconcile.
+var TemplateFields = struct {
+	Identifier string
+	Name       string
+	Bit        string
+	Build      string
+	Location   string
+	Type       string
+}{
+	Identifier: "Identifier",
+	Name:       "Name",
+	Bit:        "Bit",
+	Build:      "Build",
+	Location:   "Location",
+	Type:       "Type",
+}
@@ -32,4 +32,6 @@ var _ = Describe("Object Template", func() {
 	}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.TemplateFields)
 })
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -59,12 +61,23 @@ type client struct {
 	baseURL           string
 	parseEngineErrors bool
 	metricReceiver    MetricReceiver
+	redactedFields    []string
+	tracer            trace.Tracer
+	propagator        propagation.TextMapPropagator
+
+	// tokenSource, when set, is called to retrieve the token for every request instead of using token.
+	tokenSource  func() (string, error)
+	environments map[string]string
 }
 
 // Logger returns the logger of the given client, if provided.
 // It usually should not be used by external callers and is just there to provide a workaround for our generic API.
 func (c client) Logger() logr.Logger { return c.logger }
 
+// Environments returns the environment path segments per API group configured via profile, see Profile.
+// It usually should not be used by external callers and is just there for the generic API to apply them.
+func (c client) Environments() map[string]string { return c.environments }
+
 type clientOptions struct {
 	client
 	ignoreMissingToken bool
@@ -82,6 +95,7 @@ func AuthFromEnv(unset bool) Option {
 func TokenFromString(token string) Option {
 	return func(o *clientOptions) error {
 		o.token = token
+		o.tokenSource = nil
 
 		return nil
 	}
@@ -103,6 +117,7 @@ func TokenFromEnv(unset bool) Option {
 			return fmt.Errorf("%w: %s", ErrEnvMissing, TokenEnvName)
 		}
 		o.token = token
+		o.tokenSource = nil
 		if unset {
 			if err := os.Unsetenv(TokenEnvName); err != nil {
 				return fmt.Errorf("could not unset %s: %w", TokenEnvName, err)
@@ -248,7 +263,7 @@ func New(options ...Option) (Client, error) {
 		co.client.userAgent = fmt.Sprintf("go-anxcloud/%s (%s)", version, runtime.GOOS)
 	}
 
-	if co.client.token == "" && !co.ignoreMissingToken {
+	if co.client.token == "" && co.client.tokenSource == nil && !co.ignoreMissingToken {
 		return nil, fmt.Errorf("%w: token not set", ErrConfiguration)
 	}
 
@@ -288,8 +303,16 @@ func (c client) BaseURL() string {
 }
 
 func (c client) Do(req *http.Request) (*http.Response, error) {
-	if c.token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Token %v", c.token))
+	token := c.token
+	if c.tokenSource != nil {
+		var err error
+		if token, err = c.tokenSource(); err != nil {
+			return nil, fmt.Errorf("could not retrieve token: %w", err)
+		}
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Token %v", token))
 	}
 
 	req.Header.Set("User-Agent", c.userAgent)
@@ -309,7 +332,9 @@ func (c client) handleRequest(req *http.Request) (*http.Response, error) {
 		logger = l
 	}
 
-	logRequest(req, logger)
+	req, endSpan := c.startRequestSpan(req)
+
+	logRequest(req, logger, c.redactedFields...)
 
 	client := c.httpClient
 
@@ -323,14 +348,16 @@ func (c client) handleRequest(req *http.Request) (*http.Response, error) {
 	// it's a common HTTP feature and the Engine might use them in the future.
 
 	if c.parseEngineErrors && err == nil {
-		err = parseEngineError(req, response)
+		err = parseEngineError(req, response, c.redactedFields...)
 	}
 
+	endSpan(response, err)
+
 	if err != nil {
 		return response, err
 	}
 
-	logResponse(response, logger)
+	logResponse(response, logger, c.redactedFields...)
 
 	return response, err
 }
@@ -341,5 +368,6 @@ func defaultClient() client {
 		logger:            logr.Discard(),
 		baseURL:           defaultBaseURL,
 		httpClient:        http.DefaultClient,
+		redactedFields:    append([]string(nil), DefaultRedactedFields...),
 	}
 }
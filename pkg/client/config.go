@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// TokenFileEnvName is the name of the environment variable that can contain the path to a file containing the API token.
+	TokenFileEnvName = "ANEXIA_TOKEN_FILE" //nolint:gosec // This is a name, not a secret.
+	// BaseURLEnvName is the name of the environment variable that can contain the base URL to use.
+	BaseURLEnvName = "ANEXIA_BASE_URL"
+	// ConfigEnvName is the name of the environment variable that can contain the path to the config file.
+	ConfigEnvName = "ANEXIA_CONFIG"
+	// ProfileEnvName is the name of the environment variable that can contain the name of the profile to use.
+	ProfileEnvName = "ANEXIA_PROFILE"
+
+	// DefaultProfileName is the name of the profile used when no profile is selected otherwise.
+	DefaultProfileName = "default"
+)
+
+var (
+	// ErrProfileNotFound is returned when the requested profile does not exist in the config file.
+	ErrProfileNotFound = errors.New("profile not found")
+
+	// ErrInvalidConfig is returned when the config file cannot be parsed or contains invalid values.
+	ErrInvalidConfig = errors.New("invalid config")
+)
+
+// Profile is a named set of settings in the config file.
+type Profile struct {
+	// Token is the API token to use, takes precedence over TokenFile.
+	Token string `json:"token,omitempty" anxcloud:"secret"`
+
+	// TokenFile is the path to a file containing the API token, relative paths are resolved relative to the
+	// config file. The file is read again when it changes, allowing the token to be rotated.
+	TokenFile string `json:"token_file,omitempty"`
+
+	// BaseURL overrides the default Engine URL.
+	BaseURL string `json:"base_url,omitempty"`
+
+	// UserAgent overrides the default user agent.
+	UserAgent string `json:"user_agent,omitempty"`
+
+	// Environments maps API groups (e.g. "kubernetes/v1") to the environment path segment to use for them, as
+	// EnvironmentOption in pkg/api does. They are applied by the generic API when it creates the client, to
+	// override them for single requests EnvironmentOption has to be given with override set.
+	Environments map[string]string `json:"environments,omitempty"`
+}
+
+// Config is the content of the config file.
+//
+// Example:
+//
+//	{
+//	  "default_profile": "production",
+//	  "profiles": {
+//	    "production": { "token_file": "/run/secrets/anexia-token" },
+//	    "staging": {
+//	      "token": "...",
+//	      "environments": { "kubernetes/v1": "kubernetes-stg" }
+//	    }
+//	  }
+//	}
+type Config struct {
+	// DefaultProfile is the profile to use when none is selected, falls back to DefaultProfileName.
+	DefaultProfile string `json:"default_profile,omitempty"`
+
+	Profiles map[string]Profile `json:"profiles"`
+
+	path string
+}
+
+// DefaultConfigPath returns the path of the config file, which is the value of the ConfigEnvName environment
+// variable or "anexia/config.json" in the users config directory (see os.UserConfigDir).
+func DefaultConfigPath() (string, error) {
+	if path, ok := os.LookupEnv(ConfigEnvName); ok && path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "anexia", "config.json"), nil
+}
+
+// LoadConfig reads the config file at the given path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	config := Config{path: path}
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrInvalidConfig, path, err)
+	}
+
+	return &config, nil
+}
+
+// Profile returns the profile with the given name. When name is empty, the profile named in the ProfileEnvName
+// environment variable, the DefaultProfile of the config or DefaultProfileName is returned.
+func (c Config) Profile(name string) (Profile, error) {
+	if name == "" {
+		name = os.Getenv(ProfileEnvName)
+	}
+
+	if name == "" {
+		name = c.DefaultProfile
+	}
+
+	if name == "" {
+		name = DefaultProfileName
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%w: %q", ErrProfileNotFound, name)
+	}
+
+	if profile.TokenFile != "" && !filepath.IsAbs(profile.TokenFile) && c.path != "" {
+		profile.TokenFile = filepath.Join(filepath.Dir(c.path), profile.TokenFile)
+	}
+
+	return profile, nil
+}
+
+func (p Profile) apply(o *clientOptions) error {
+	if p.Token != "" {
+		o.token = p.Token
+		o.tokenSource = nil
+	} else if p.TokenFile != "" {
+		if err := TokenFromFile(p.TokenFile)(o); err != nil {
+			return err
+		}
+	}
+
+	if p.BaseURL != "" {
+		if err := BaseURL(p.BaseURL)(o); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidConfig, err)
+		}
+	}
+
+	if p.UserAgent != "" {
+		o.userAgent = p.UserAgent
+	}
+
+	if len(p.Environments) > 0 {
+		o.environments = make(map[string]string, len(p.Environments))
+		for group, segment := range p.Environments {
+			o.environments[group] = segment
+		}
+	}
+
+	return nil
+}
+
+// FromProfile configures the client with the named profile from the config file at DefaultConfigPath. An empty
+// name selects the profile as described in Config.Profile.
+func FromProfile(name string) Option {
+	return func(o *clientOptions) error {
+		path, err := DefaultConfigPath()
+		if err != nil {
+			return err
+		}
+
+		return FromProfileFile(path, name)(o)
+	}
+}
+
+// FromProfileFile configures the client with the named profile from the config file at the given path. An
+// empty name selects the profile as described in Config.Profile.
+func FromProfileFile(path, name string) Option {
+	return func(o *clientOptions) error {
+		config, err := LoadConfig(path)
+		if err != nil {
+			return err
+		}
+
+		profile, err := config.Profile(name)
+		if err != nil {
+			return err
+		}
+
+		return profile.apply(o)
+	}
+}
+
+// FromDefaultChain configures the client from the following sources, later ones overriding earlier ones:
+//
+//  1. the profile selected as described in Config.Profile from the config file at DefaultConfigPath, if the file
+//     exists. The file is required when a config path or profile is given via environment variables.
+//  2. the token file at the path in the TokenFileEnvName environment variable.
+//  3. the token in the TokenEnvName environment variable.
+//  4. the base URL in the BaseURLEnvName environment variable.
+//
+// When none of them provides a token, New returns an error unless IgnoreMissingToken is given.
+func FromDefaultChain() Option {
+	return func(o *clientOptions) error {
+		_, explicitConfig := os.LookupEnv(ConfigEnvName)
+		_, explicitProfile := os.LookupEnv(ProfileEnvName)
+
+		// without a config directory there is no default config file, that's only fine if none was requested
+		path, err := DefaultConfigPath()
+		if err != nil && explicitProfile {
+			return err
+		} else if err == nil {
+			if _, statErr := os.Stat(path); statErr == nil || explicitConfig || explicitProfile {
+				if err := FromProfileFile(path, "")(o); err != nil {
+					return err
+				}
+			}
+		}
+
+		if tokenFile, ok := os.LookupEnv(TokenFileEnvName); ok && tokenFile != "" {
+			if err := TokenFromFile(tokenFile)(o); err != nil {
+				return err
+			}
+		}
+
+		if token, ok := os.LookupEnv(TokenEnvName); ok && token != "" {
+			o.token = token
+			o.tokenSource = nil
+		}
+
+		if baseURL, ok := os.LookupEnv(BaseURLEnvName); ok && baseURL != "" {
+			if err := BaseURL(baseURL)(o); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// TokenFromFile reads the API token from the file at the given path. The file is checked for changes before
+// every request and read again when it changed, allowing the token to be rotated without creating a new client.
+func TokenFromFile(path string) Option {
+	return func(o *clientOptions) error {
+		ft := &fileToken{path: path}
+		if _, err := ft.Token(); err != nil {
+			return err
+		}
+
+		o.token = ""
+		o.tokenSource = ft.Token
+		return nil
+	}
+}
+
+type fileToken struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// Token returns the token in the file, reading it again if the file changed since it was read last.
+func (ft *fileToken) Token() (string, error) {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	stat, err := os.Stat(ft.path)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file: %w", err)
+	}
+
+	if ft.token != "" && stat.ModTime().Equal(ft.modTime) && stat.Size() == ft.size {
+		return ft.token, nil
+	}
+
+	data, err := os.ReadFile(ft.path)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("%w: token file %q is empty", ErrConfiguration, ft.path)
+	}
+
+	ft.token = token
+	ft.modTime = stat.ModTime()
+	ft.size = stat.Size()
+
+	return token, nil
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("config and credential chain", func() {
+	var dir string
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	newClient := func(opts ...Option) *client {
+		c, err := New(opts...)
+		Expect(err).NotTo(HaveOccurred())
+		return c.(*client)
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		for _, env := range []string{TokenEnvName, TokenFileEnvName, BaseURLEnvName, ConfigEnvName, ProfileEnvName} {
+			if value, ok := os.LookupEnv(env); ok {
+				DeferCleanup(os.Setenv, env, value)
+				Expect(os.Unsetenv(env)).To(Succeed())
+			}
+		}
+
+		// never read a config file of the user running the tests
+		GinkgoT().Setenv("XDG_CONFIG_HOME", dir)
+		GinkgoT().Setenv("HOME", dir)
+	})
+
+	Context("config file", func() {
+		var configPath string
+
+		BeforeEach(func() {
+			writeFile("token", "file-token\n")
+			configPath = writeFile("config.json", `{
+				"default_profile": "production",
+				"profiles": {
+					"production": {
+						"token_file": "token",
+						"user_agent": "my-service/1.0"
+					},
+					"staging": {
+						"token": "staging-token",
+						"base_url": "https://engine-staging.example.com/",
+						"environments": { "kubernetes/v1": "kubernetes-stg" }
+					}
+				}
+			}`)
+		})
+
+		It("uses the default profile of the config", func() {
+			c := newClient(FromProfileFile(configPath, ""))
+
+			token, err := c.tokenSource()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("file-token"))
+			Expect(c.userAgent).To(Equal("my-service/1.0"))
+			Expect(c.BaseURL()).To(Equal(defaultBaseURL))
+		})
+
+		It("uses the named profile", func() {
+			c := newClient(FromProfileFile(configPath, "staging"))
+
+			Expect(c.token).To(Equal("staging-token"))
+			Expect(c.tokenSource).To(BeNil())
+			Expect(c.BaseURL()).To(Equal("https://engine-staging.example.com"))
+			Expect(c.Environments()).To(Equal(map[string]string{"kubernetes/v1": "kubernetes-stg"}))
+		})
+
+		It("uses the profile selected via environment", func() {
+			GinkgoT().Setenv(ProfileEnvName, "staging")
+			GinkgoT().Setenv(ConfigEnvName, configPath)
+
+			c := newClient(FromProfile(""))
+			Expect(c.token).To(Equal("staging-token"))
+		})
+
+		It("lets later options override the profile", func() {
+			c := newClient(FromProfileFile(configPath, "staging"), TokenFromString("explicit-token"))
+			Expect(c.token).To(Equal("explicit-token"))
+		})
+
+		It("returns an error for unknown profiles", func() {
+			_, err := New(FromProfileFile(configPath, "unknown"))
+			Expect(err).To(MatchError(ErrProfileNotFound))
+		})
+
+		It("returns an error for invalid config files", func() {
+			path := writeFile("invalid.json", `{"profiles": {"default": {"tokn": "typo"}}}`)
+
+			_, err := New(FromProfileFile(path, ""))
+			Expect(err).To(MatchError(ErrInvalidConfig))
+		})
+
+		It("returns an error for missing config files", func() {
+			_, err := New(FromProfileFile(filepath.Join(dir, "missing.json"), ""))
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+	})
+
+	Context("token file", func() {
+		It("reads the token again after it changed", func() {
+			srv := ghttp.NewServer()
+			DeferCleanup(srv.Close)
+
+			path := writeFile("token", "first-token")
+
+			c := newClient(TokenFromFile(path), BaseURL(srv.URL()))
+
+			srv.AppendHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Token first-token"),
+				ghttp.VerifyHeaderKV("Authorization", "Token second-token"),
+			)
+
+			req, err := http.NewRequest("GET", srv.URL(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+
+			writeFile("token", "second-token")
+			// make sure the change is detected on file systems with coarse timestamps
+			Expect(os.Chtimes(path, time.Now(), time.Now().Add(time.Second))).To(Succeed())
+
+			req, err = http.NewRequest("GET", srv.URL(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error for empty token files", func() {
+			path := writeFile("token", "  \n")
+
+			_, err := New(TokenFromFile(path))
+			Expect(err).To(MatchError(ErrConfiguration))
+		})
+
+		It("returns an error when the token file vanishes", func() {
+			path := writeFile("token", "token")
+			c := newClient(TokenFromFile(path))
+
+			Expect(os.Remove(path)).To(Succeed())
+
+			req, err := http.NewRequest("GET", "http://localhost", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Do(req)
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+	})
+
+	Context("default chain", func() {
+		It("fails without any token source", func() {
+			_, err := New(FromDefaultChain())
+			Expect(err).To(MatchError(ErrConfiguration))
+		})
+
+		It("uses the default config file when it exists", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "anexia"), 0o700)).To(Succeed())
+			writeFile("anexia/config.json", `{"profiles": {"default": {"token": "profile-token"}}}`)
+
+			path, err := DefaultConfigPath()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(HavePrefix(dir))
+
+			c := newClient(FromDefaultChain())
+			Expect(c.token).To(Equal("profile-token"))
+		})
+
+		It("requires the config file when given via environment", func() {
+			GinkgoT().Setenv(ConfigEnvName, filepath.Join(dir, "missing.json"))
+
+			_, err := New(FromDefaultChain())
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+
+		It("lets environment variables override the profile", func() {
+			configPath := writeFile("config.json", `{"profiles": {"default": {"token": "profile-token", "base_url": "https://profile.example.com"}}}`)
+			GinkgoT().Setenv(ConfigEnvName, configPath)
+
+			c := newClient(FromDefaultChain())
+			Expect(c.token).To(Equal("profile-token"))
+			Expect(c.BaseURL()).To(Equal("https://profile.example.com"))
+
+			GinkgoT().Setenv(TokenFileEnvName, writeFile("token", "file-token"))
+			c = newClient(FromDefaultChain())
+			token, err := c.tokenSource()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("file-token"))
+
+			GinkgoT().Setenv(TokenEnvName, "env-token")
+			GinkgoT().Setenv(BaseURLEnvName, "https://env.example.com")
+			c = newClient(FromDefaultChain())
+			Expect(c.token).To(Equal("env-token"))
+			Expect(c.tokenSource).To(BeNil())
+			Expect(c.BaseURL()).To(Equal("https://env.example.com"))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // ResponseError is a response from the API that indicates an error.
@@ -18,15 +19,35 @@ type ResponseError struct {
 	Debug struct {
 		Source string `json:"source"`
 	} `json:"debug"`
+
+	redactedFields []string
 }
 
+// Error returns the error message, with validation messages of fields configured to be redacted replaced.
 func (r ResponseError) Error() string {
-	return fmt.Sprintf("received error from api: %+v", r.ErrorData)
+	errorData := r.ErrorData
+
+	if len(errorData.Validation) > 0 {
+		validation := make(map[string]string, len(errorData.Validation))
+		for field, message := range errorData.Validation {
+			if matchesRedactedField(strings.Split(field, "."), fieldPatterns(r.redactedFields)) {
+				message = RedactedValue
+			}
+			validation[field] = message
+		}
+		errorData.Validation = validation
+	}
+
+	return fmt.Sprintf("received error from api: %+v", errorData)
 }
 
-func parseEngineError(req *http.Request, res *http.Response) error {
+func parseEngineError(req *http.Request, res *http.Response, redactedFields ...string) error {
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
-		errResponse := ResponseError{Request: req, Response: res}
+		if req != nil {
+			redactedFields = append(redactedFields, redactedFieldsFromContext(req.Context())...)
+		}
+
+		errResponse := ResponseError{Request: req, Response: res, redactedFields: redactedFields}
 		if decodeErr := json.NewDecoder(res.Body).Decode(&errResponse); decodeErr != nil {
 			return fmt.Errorf("could not decode error response: %w", decodeErr)
 		}
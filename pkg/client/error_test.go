@@ -30,6 +30,26 @@ var _ = Describe("ResponseError", func() {
 		Expect(err.Error()).To(ContainSubstring("Message:Something went wrong. Please contact support."))
 	})
 
+	It("redacts validation messages of secret fields", func() {
+		resW := httptest.NewRecorder()
+		resW.WriteHeader(422)
+		resW.Header().Add("Content-Type", "application/json; charset=utf-8")
+		_, _ = resW.Write([]byte(`{ "error": { "code": 422, "message": "Validation failed", "validation": { "password": "hunter2 is too weak", "name": "name is required" } } }`))
+
+		req := httptest.NewRequest("POST", "https://engine.anexia.com/api/foo/bar", nil)
+		res := resW.Result()
+
+		err := parseEngineError(req, res, DefaultRedactedFields...)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).NotTo(ContainSubstring("hunter2"))
+		Expect(err.Error()).To(ContainSubstring("password:REDACTED"))
+		Expect(err.Error()).To(ContainSubstring("name is required"))
+
+		resError := &ResponseError{}
+		Expect(errors.As(err, &resError)).To(BeTrue())
+		Expect(resError.ErrorData.Validation).To(HaveKeyWithValue("password", "hunter2 is too weak"))
+	})
+
 	It("returns an error for invalid error responses", func() {
 		resW := httptest.NewRecorder()
 		resW.WriteHeader(500)
@@ -42,7 +42,7 @@ func traceLogger(log logr.Logger) logr.Logger {
 	return log.WithName(LogNameTrace).V(LogVerbosityRequests)
 }
 
-func logRequest(req *http.Request, logger logr.Logger) {
+func logRequest(req *http.Request, logger logr.Logger, redactedFields ...string) {
 	log := traceLogger(logger)
 
 	if req == nil || !log.Enabled() {
@@ -50,10 +50,11 @@ func logRequest(req *http.Request, logger logr.Logger) {
 	}
 
 	headers := req.Header.Clone()
-	headers.Set("Authorization", "REDACTED")
+	headers.Set("Authorization", RedactedValue)
 
 	if body := stringifyBody(&req.Body, logger); body != nil {
-		log = log.WithValues("body", *body)
+		redactedFields = append(redactedFields, redactedFieldsFromContext(req.Context())...)
+		log = log.WithValues("body", redactString(*body, redactedFields))
 	}
 
 	log.Info("Sending request to Engine",
@@ -63,7 +64,7 @@ func logRequest(req *http.Request, logger logr.Logger) {
 	)
 }
 
-func logResponse(res *http.Response, logger logr.Logger) {
+func logResponse(res *http.Response, logger logr.Logger, redactedFields ...string) {
 	log := traceLogger(logger)
 
 	if res == nil || !log.Enabled() {
@@ -71,10 +72,13 @@ func logResponse(res *http.Response, logger logr.Logger) {
 	}
 
 	headers := res.Header.Clone()
-	headers.Set("Set-Cookie", "REDACTED")
+	headers.Set("Set-Cookie", RedactedValue)
 
 	if body := stringifyBody(&res.Body, logger); body != nil {
-		log = log.WithValues("body", *body)
+		if res.Request != nil {
+			redactedFields = append(redactedFields, redactedFieldsFromContext(res.Request.Context())...)
+		}
+		log = log.WithValues("body", redactString(*body, redactedFields))
 	}
 
 	if res.Request != nil {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -73,6 +74,36 @@ var _ = Describe("logRequest and logResponse", func() {
 			Expect(fullLog.String()).NotTo(ContainSubstring(`"method"=`))
 		})
 
+		It("redacts secret fields in the request body", func() {
+			req := httptest.NewRequest("POST", "/foo", strings.NewReader(`{"name":"foo","password":"hunter2"}`))
+
+			logRequest(req, logger, DefaultRedactedFields...)
+
+			Expect(fullLog.String()).NotTo(ContainSubstring("hunter2"))
+			Expect(fullLog.String()).To(ContainSubstring("REDACTED"))
+			Expect(fullLog.String()).To(ContainSubstring("foo"))
+
+			body, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(ContainSubstring("hunter2"))
+		})
+
+		It("redacts fields configured on the request context in the response body", func() {
+			w := httptest.NewRecorder()
+			_, err := w.Write([]byte(`{"data":{"kubeconfig":"very secret"}}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			res := w.Result()
+			res.Request = httptest.NewRequest("GET", "/foo", nil).WithContext(
+				ContextWithRedactedFields(context.TODO(), "data.kubeconfig"),
+			)
+
+			logResponse(res, logger)
+
+			Expect(fullLog.String()).NotTo(ContainSubstring("very secret"))
+			Expect(fullLog.String()).To(ContainSubstring("REDACTED"))
+		})
+
 		It("does not mangle the request body", func() {
 			req := httptest.NewRequest("GET", "/foo", bytes.NewBuffer([]byte("OK")))
 
@@ -21,6 +21,15 @@ const (
 	// MetricRequestInflight is the number of requests currently waiting for a response. It is a counter,
 	// delivered as 1 for increment and -1 for decrement.
 	MetricRequestInflight Metric = "http_requests_in_flight"
+
+	// MetricOperationDuration is the time in seconds a generic API operation took, including all requests made
+	// for it. It is emitted by the generic API in pkg/api, with the labels MetricLabelResource (the Object type),
+	// MetricLabelOperation and MetricLabelStatus ("success" or "error").
+	MetricOperationDuration Metric = "operation_duration_seconds"
+
+	// MetricOperationCount is the number of generic API operations done for the given labels, see
+	// MetricOperationDuration. It is a counter, delivered as 1 for increment.
+	MetricOperationCount Metric = "operation_total"
 )
 
 // MetricLabel is the key for the labels-map we give when passing metrics to the receiver. It again is just a
@@ -38,6 +47,9 @@ const (
 
 	// MetricLabelStatus contains the status code we received for the request.
 	MetricLabelStatus MetricLabel = "status"
+
+	// MetricLabelOperation contains the generic API operation (Get, List, Create, ..) for operation metrics.
+	MetricLabelOperation MetricLabel = "operation"
 )
 
 // MetricReceiver receives a bunch of metrics with the same labels. Counter metrics will be delivered
@@ -0,0 +1,197 @@
+// Package prometheus provides a client.MetricReceiver recording the client and generic API metrics with
+// Prometheus collectors.
+//
+// Create a Collector, register it and pass its Receive method to the client or generic API:
+//
+//	collector := prometheus.NewCollector()
+//	prom.MustRegister(collector)
+//
+//	a, err := api.NewAPI(api.WithMetricReceiver(collector.Receive))
+package prometheus
+
+import (
+	"regexp"
+	"strings"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+// IdentifierPlaceholder replaces identifiers in the resource label of request metrics.
+const IdentifierPlaceholder = "{identifier}"
+
+var identifierPattern = regexp.MustCompile(`^([0-9a-fA-F]{32}|[0-9a-fA-F]{8}(-[0-9a-fA-F]{4}){3}-[0-9a-fA-F]{12}|[0-9]+)$`)
+
+// NormalizeResource replaces the identifiers in the given Engine URL path with IdentifierPlaceholder, keeping
+// the cardinality of the resource label low. Identifiers are segments following a "*.json" or "tags" segment
+// and segments looking like an identifier (hexadecimal, UUID or numeric).
+//
+// Example: "/api/core/v1/resource.json/f00ba4/tags/foo" becomes "/api/core/v1/resource.json/{identifier}/tags/{identifier}".
+func NormalizeResource(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		previous := ""
+		if i > 0 {
+			previous = segments[i-1]
+		}
+
+		if strings.HasSuffix(previous, ".json") || previous == "tags" || identifierPattern.MatchString(segment) {
+			segments[i] = IdentifierPlaceholder
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// Option configures a Collector.
+type Option func(*options)
+
+type options struct {
+	namespace  string
+	buckets    []float64
+	normalizer func(string) string
+}
+
+// WithNamespace prefixes all metric names with the given namespace, e.g. "anxcloud_http_request_total".
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithBuckets configures the buckets of the duration histograms, defaulting to prometheus.DefBuckets.
+func WithBuckets(buckets ...float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// WithResourceNormalizer replaces NormalizeResource as function to normalize the resource label of request metrics.
+func WithResourceNormalizer(normalizer func(path string) string) Option {
+	return func(o *options) {
+		o.normalizer = normalizer
+	}
+}
+
+// Collector records the metrics given to its Receive method. It is a prometheus.Collector and has to be
+// registered with a prometheus.Registerer to be exported.
+type Collector struct {
+	normalizer func(string) string
+
+	requestDuration   *prom.HistogramVec
+	requestCount      *prom.CounterVec
+	requestInflight   *prom.GaugeVec
+	operationDuration *prom.HistogramVec
+	operationCount    *prom.CounterVec
+}
+
+var _ prom.Collector = (*Collector)(nil)
+
+// NewCollector creates a new Collector with the given options.
+func NewCollector(opts ...Option) *Collector {
+	o := options{
+		buckets:    prom.DefBuckets,
+		normalizer: NormalizeResource,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	requestLabels := []string{string(client.MetricLabelResource), string(client.MetricLabelMethod), string(client.MetricLabelStatus)}
+	operationLabels := []string{string(client.MetricLabelResource), string(client.MetricLabelOperation), string(client.MetricLabelStatus)}
+
+	return &Collector{
+		normalizer: o.normalizer,
+
+		requestDuration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      string(client.MetricRequestDuration),
+			Help:      "Duration of requests to the Engine in seconds.",
+			Buckets:   o.buckets,
+		}, requestLabels),
+		requestCount: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: o.namespace,
+			Name:      string(client.MetricRequestCount),
+			Help:      "Number of requests sent to the Engine.",
+		}, requestLabels),
+		// the status is not known yet for requests in flight
+		requestInflight: prom.NewGaugeVec(prom.GaugeOpts{
+			Namespace: o.namespace,
+			Name:      string(client.MetricRequestInflight),
+			Help:      "Number of requests to the Engine currently waiting for a response.",
+		}, requestLabels[:2]),
+		operationDuration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      string(client.MetricOperationDuration),
+			Help:      "Duration of generic API operations in seconds, including all requests made for them.",
+			Buckets:   o.buckets,
+		}, operationLabels),
+		operationCount: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: o.namespace,
+			Name:      string(client.MetricOperationCount),
+			Help:      "Number of generic API operations done.",
+		}, operationLabels),
+	}
+}
+
+// Receive records the given metrics, it is a client.MetricReceiver.
+func (c *Collector) Receive(metrics map[client.Metric]float64, labels map[client.MetricLabel]string) {
+	resource := labels[client.MetricLabelResource]
+	method := labels[client.MetricLabelMethod]
+	status := labels[client.MetricLabelStatus]
+	operation := labels[client.MetricLabelOperation]
+
+	for metric, value := range metrics {
+		switch metric {
+		case client.MetricRequestDuration:
+			c.requestDuration.WithLabelValues(c.normalizer(resource), method, status).Observe(value)
+		case client.MetricRequestCount:
+			// counters cannot be decremented
+			if value > 0 {
+				c.requestCount.WithLabelValues(c.normalizer(resource), method, status).Add(value)
+			}
+		case client.MetricRequestInflight:
+			c.requestInflight.WithLabelValues(c.normalizer(resource), method).Add(value)
+		case client.MetricOperationDuration:
+			c.operationDuration.WithLabelValues(resource, operation, status).Observe(value)
+		case client.MetricOperationCount:
+			if value > 0 {
+				c.operationCount.WithLabelValues(resource, operation, status).Add(value)
+			}
+		}
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requestDuration.Describe(ch)
+	c.requestCount.Describe(ch)
+	c.requestInflight.Describe(ch)
+	c.operationDuration.Describe(ch)
+	c.operationCount.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requestDuration.Collect(ch)
+	c.requestCount.Collect(ch)
+	c.requestInflight.Collect(ch)
+	c.operationDuration.Collect(ch)
+	c.operationCount.Collect(ch)
+}
+
+// Register creates a new Collector with the given options and registers it with the given Registerer.
+func Register(registerer prom.Registerer, opts ...Option) (*Collector, error) {
+	c := NewCollector(opts...)
+	if err := registerer.Register(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,118 @@
+package prometheus_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	vlanv1 "go.anx.io/go-anxcloud/pkg/apis/vlan/v1"
+	"go.anx.io/go-anxcloud/pkg/client"
+	"go.anx.io/go-anxcloud/pkg/client/prometheus"
+)
+
+var _ = Describe("NormalizeResource", func() {
+	DescribeTable("replaces identifiers",
+		func(path, expected string) {
+			Expect(prometheus.NormalizeResource(path)).To(Equal(expected))
+		},
+		Entry("collection", "/api/vlan/v1/vlan.json", "/api/vlan/v1/vlan.json"),
+		Entry("object", "/api/vlan/v1/vlan.json/some-identifier", "/api/vlan/v1/vlan.json/{identifier}"),
+		Entry("tags", "/api/core/v1/resource.json/foo/tags/bar", "/api/core/v1/resource.json/{identifier}/tags/{identifier}"),
+		Entry("hex identifier in legacy path", "/api/vsphere/v1/info.json/0123456789abcdef0123456789abcdef/info", "/api/vsphere/v1/info.json/{identifier}/info"),
+		Entry("UUID", "/api/foo/v1/progress/01234567-89ab-cdef-0123-456789abcdef", "/api/foo/v1/progress/{identifier}"),
+		Entry("numeric", "/api/foo/v1/page/42", "/api/foo/v1/page/{identifier}"),
+	)
+})
+
+var _ = Describe("Collector", func() {
+	var (
+		collector *prometheus.Collector
+		registry  *prom.Registry
+	)
+
+	BeforeEach(func() {
+		registry = prom.NewPedanticRegistry()
+
+		var err error
+		collector, err = prometheus.Register(registry, prometheus.WithNamespace("anxcloud"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("records request metrics with normalized resources", func() {
+		labels := map[client.MetricLabel]string{
+			client.MetricLabelResource: "/api/vlan/v1/vlan.json/foo",
+			client.MetricLabelMethod:   "GET",
+		}
+		collector.Receive(map[client.Metric]float64{client.MetricRequestInflight: 1}, labels)
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP anxcloud_http_requests_in_flight Number of requests to the Engine currently waiting for a response.
+# TYPE anxcloud_http_requests_in_flight gauge
+anxcloud_http_requests_in_flight{method="GET",resource="/api/vlan/v1/vlan.json/{identifier}"} 1
+`), "anxcloud_http_requests_in_flight")).To(Succeed())
+
+		labels[client.MetricLabelStatus] = "200"
+		collector.Receive(map[client.Metric]float64{
+			client.MetricRequestInflight: -1,
+			client.MetricRequestCount:    1,
+			client.MetricRequestDuration: 0.5,
+		}, labels)
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP anxcloud_http_request_total Number of requests sent to the Engine.
+# TYPE anxcloud_http_request_total counter
+anxcloud_http_request_total{method="GET",resource="/api/vlan/v1/vlan.json/{identifier}",status="200"} 1
+# HELP anxcloud_http_requests_in_flight Number of requests to the Engine currently waiting for a response.
+# TYPE anxcloud_http_requests_in_flight gauge
+anxcloud_http_requests_in_flight{method="GET",resource="/api/vlan/v1/vlan.json/{identifier}"} 0
+`), "anxcloud_http_request_total", "anxcloud_http_requests_in_flight")).To(Succeed())
+		Expect(testutil.CollectAndCount(registry, "anxcloud_http_request_duration_seconds")).To(Equal(1))
+	})
+
+	It("collects request and operation metrics from the generic API", func() {
+		srv := ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		srv.AppendHandlers(
+			ghttp.RespondWithJSONEncoded(200, map[string]string{"identifier": "foo"}),
+			ghttp.RespondWith(404, "{}"),
+		)
+
+		a, err := api.NewAPI(
+			api.WithClientOptions(
+				client.BaseURL(srv.URL()),
+				client.IgnoreMissingToken(),
+			),
+			api.WithMetricReceiver(collector.Receive),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(a.Get(context.TODO(), &vlanv1.VLAN{Identifier: "foo"})).To(Succeed())
+		Expect(a.Get(context.TODO(), &vlanv1.VLAN{Identifier: "bar"})).To(MatchError(api.ErrNotFound))
+
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP anxcloud_http_request_total Number of requests sent to the Engine.
+# TYPE anxcloud_http_request_total counter
+anxcloud_http_request_total{method="GET",resource="/api/vlan/v1/vlan.json/{identifier}",status="200"} 1
+anxcloud_http_request_total{method="GET",resource="/api/vlan/v1/vlan.json/{identifier}",status="404"} 1
+# HELP anxcloud_operation_total Number of generic API operations done.
+# TYPE anxcloud_operation_total counter
+anxcloud_operation_total{operation="Get",resource="vlan/v1.VLAN",status="error"} 1
+anxcloud_operation_total{operation="Get",resource="vlan/v1.VLAN",status="success"} 1
+`), "anxcloud_http_request_total", "anxcloud_operation_total")).To(Succeed())
+
+		Expect(testutil.CollectAndCount(registry, "anxcloud_operation_duration_seconds")).To(Equal(2))
+	})
+})
+
+func TestPrometheus(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "client/prometheus test suite")
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the values of secret fields in logged request and response bodies and error messages.
+const RedactedValue = "REDACTED"
+
+// DefaultRedactedFields are the fields redacted by every client, see RedactFields for the syntax.
+var DefaultRedactedFields = []string{
+	"password", "*_password",
+	"secret", "*_secret",
+	"secret_key", "*_secret_key",
+	"private_key", "*_private_key",
+	"token", "*_token",
+	"kubeconfig",
+}
+
+// RedactFields configures additional fields to redact in logged request and response bodies and error
+// messages, extending DefaultRedactedFields.
+//
+// Fields are given as JSON paths with the keys separated by dots, array elements are traversed implicitly.
+// A field without dots matches the key at any depth, a field with dots matches from the root of the document
+// only. Every key can be a pattern as supported by path.Match, e.g. "*_password" or "data.*.kubeconfig".
+func RedactFields(fields ...string) Option {
+	return func(o *clientOptions) error {
+		o.redactedFields = append(o.redactedFields, fields...)
+		return nil
+	}
+}
+
+type redactedFieldsContextKey struct{}
+
+// ContextWithRedactedFields returns a context configuring additional fields to redact for requests made with it.
+// The generic API uses it to redact the fields tagged with `anxcloud:"secret"`, see SecretFields.
+func ContextWithRedactedFields(ctx context.Context, fields ...string) context.Context {
+	if len(fields) == 0 {
+		return ctx
+	}
+
+	fields = append(redactedFieldsFromContext(ctx), fields...)
+	return context.WithValue(ctx, redactedFieldsContextKey{}, fields)
+}
+
+func redactedFieldsFromContext(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+
+	fields, _ := ctx.Value(redactedFieldsContextKey{}).([]string)
+	return fields[:len(fields):len(fields)]
+}
+
+var secretFieldsCache sync.Map
+
+// SecretFields returns the JSON names of all fields of the given struct (or pointer to one), including nested
+// structs, that are tagged with `anxcloud:"secret"`. The names can be passed to RedactFields or
+// ContextWithRedactedFields.
+func SecretFields(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+
+	if cached, ok := secretFieldsCache.Load(t); ok {
+		return cached.([]string)
+	}
+
+	fields := make([]string, 0)
+	collectSecretFields(t, map[reflect.Type]bool{}, &fields)
+	fields = fields[:len(fields):len(fields)]
+	secretFieldsCache.Store(t, fields)
+
+	return fields
+}
+
+func collectSecretFields(t reflect.Type, visited map[reflect.Type]bool, fields *[]string) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || visited[t] {
+		return
+	}
+	visited[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if tag, ok := field.Tag.Lookup("anxcloud"); ok && strings.Split(tag, ",")[0] == "secret" {
+			*fields = append(*fields, jsonFieldName(field))
+			continue
+		}
+
+		if field.IsExported() {
+			collectSecretFields(field.Type, visited, fields)
+		}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return field.Name
+}
+
+// RedactJSON replaces the values of the given fields in a JSON document with RedactedValue, see RedactFields
+// for the syntax of fields. Use it to redact bodies before writing them anywhere, e.g. when recording requests.
+//
+// The document is returned unchanged if it is not valid JSON or no field matched, otherwise it is re-encoded
+// with its object keys sorted.
+func RedactJSON(data []byte, fields ...string) []byte {
+	if len(fields) == 0 {
+		return data
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return data
+	}
+
+	if !redactValue(document, nil, fieldPatterns(fields)) {
+		return data
+	}
+
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return data
+	}
+
+	return redacted
+}
+
+func fieldPatterns(fields []string) [][]string {
+	patterns := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		patterns = append(patterns, strings.Split(field, "."))
+	}
+	return patterns
+}
+
+func redactValue(v interface{}, keyPath []string, patterns [][]string) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			childPath := append(keyPath[:len(keyPath):len(keyPath)], key)
+
+			if matchesRedactedField(childPath, patterns) {
+				if value != nil {
+					v[key] = RedactedValue
+					redacted = true
+				}
+				continue
+			}
+
+			if redactValue(value, childPath, patterns) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if redactValue(value, keyPath, patterns) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func matchesRedactedField(keyPath []string, patterns [][]string) bool {
+	for _, pattern := range patterns {
+		if len(pattern) == 1 {
+			if match, _ := path.Match(pattern[0], keyPath[len(keyPath)-1]); match {
+				return true
+			}
+			continue
+		}
+
+		if len(pattern) != len(keyPath) {
+			continue
+		}
+
+		matched := true
+		for i := range pattern {
+			if match, _ := path.Match(pattern[i], keyPath[i]); !match {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactString redacts the body if it is JSON, other content is returned unchanged.
+func redactString(body string, fields []string) string {
+	return string(RedactJSON([]byte(body), fields...))
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type redactTestNested struct {
+	Token string `json:"api_token" anxcloud:"secret"`
+}
+
+type redactTestObject struct {
+	Name     string             `json:"name"`
+	Password string             `json:"password,omitempty" anxcloud:"secret"`
+	Nested   []redactTestNested `json:"nested"`
+	Embedded *redactTestObject  `json:"embedded"`
+	Untagged string             `anxcloud:"secret"`
+}
+
+var _ = Describe("redaction", func() {
+	DescribeTable("RedactJSON",
+		func(document string, fields []string, expected string) {
+			redacted := RedactJSON([]byte(document), fields...)
+			Expect(redacted).To(MatchJSON(expected))
+		},
+		Entry("redacts keys at any depth",
+			`{"password":"foo","data":[{"password":"bar","name":"baz"}]}`,
+			[]string{"password"},
+			`{"password":"REDACTED","data":[{"password":"REDACTED","name":"baz"}]}`,
+		),
+		Entry("matches key patterns",
+			`{"git_password":"foo","password_policy":"strict"}`,
+			[]string{"*_password"},
+			`{"git_password":"REDACTED","password_policy":"strict"}`,
+		),
+		Entry("matches paths from the root only",
+			`{"data":{"kubeconfig":"foo"},"kubeconfig":"bar"}`,
+			[]string{"data.kubeconfig"},
+			`{"data":{"kubeconfig":"REDACTED"},"kubeconfig":"bar"}`,
+		),
+		Entry("traverses arrays in paths",
+			`{"data":[{"secret":"foo"},{"secret":"bar"}]}`,
+			[]string{"data.secret"},
+			`{"data":[{"secret":"REDACTED"},{"secret":"REDACTED"}]}`,
+		),
+		Entry("redacts whole objects",
+			`{"credentials":{"user":"foo","pass":"bar"}}`,
+			[]string{"credentials"},
+			`{"credentials":"REDACTED"}`,
+		),
+		Entry("keeps null values",
+			`{"password":null}`,
+			[]string{"password"},
+			`{"password":null}`,
+		),
+		Entry("keeps large numbers as-is",
+			`{"password":"foo","id":12345678901234567890}`,
+			[]string{"password"},
+			`{"password":"REDACTED","id":12345678901234567890}`,
+		),
+	)
+
+	It("returns documents without matches unchanged", func() {
+		document := []byte(`{ "name": "foo" }`)
+		Expect(RedactJSON(document, DefaultRedactedFields...)).To(Equal(document))
+	})
+
+	It("returns non-JSON documents unchanged", func() {
+		document := []byte(`password=foo`)
+		Expect(RedactJSON(document, DefaultRedactedFields...)).To(Equal(document))
+	})
+
+	It("collects fields tagged as secret", func() {
+		Expect(SecretFields(&redactTestObject{})).To(ConsistOf("password", "api_token", "Untagged"))
+		Expect(SecretFields(redactTestNested{})).To(ConsistOf("api_token"))
+		Expect(SecretFields(nil)).To(BeEmpty())
+	})
+
+	It("adds fields to the context", func() {
+		ctx := ContextWithRedactedFields(context.TODO(), "foo")
+		ctx = ContextWithRedactedFields(ctx, "bar")
+		ctx = ContextWithRedactedFields(ctx)
+
+		Expect(redactedFieldsFromContext(ctx)).To(Equal([]string{"foo", "bar"}))
+	})
+
+	It("redacts a marshalled object by its secret fields", func() {
+		data, err := json.Marshal(redactTestObject{
+			Name:     "foo",
+			Password: "hunter2",
+			Nested:   []redactTestNested{{Token: "s3cr3t"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		redacted := string(RedactJSON(data, SecretFields(redactTestObject{})...))
+		Expect(redacted).NotTo(ContainSubstring("hunter2"))
+		Expect(redacted).NotTo(ContainSubstring("s3cr3t"))
+		Expect(redacted).To(ContainSubstring(`"name":"foo"`))
+	})
+})
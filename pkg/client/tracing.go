@@ -0,0 +1,96 @@
+package client
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.anx.io/go-anxcloud/pkg/utils/retry"
+)
+
+// TracerName is the name of the OpenTelemetry tracer used for spans created by this package.
+const TracerName = "go.anx.io/go-anxcloud/pkg/client"
+
+// Attributes set on the spans created for requests, following the OpenTelemetry HTTP semantic conventions.
+const (
+	AttributeHTTPMethod      = attribute.Key("http.request.method")
+	AttributeHTTPStatusCode  = attribute.Key("http.response.status_code")
+	AttributeHTTPResendCount = attribute.Key("http.request.resend_count")
+	AttributeURL             = attribute.Key("url.full")
+	AttributeServerAddress   = attribute.Key("server.address")
+)
+
+// WithTracerProvider enables tracing with the given OpenTelemetry TracerProvider, creating a client span for every
+// request sent to the Engine and propagating the trace context in the request headers. Passing nil uses the
+// global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *clientOptions) error {
+		if tp == nil {
+			tp = otel.GetTracerProvider()
+		}
+
+		o.tracer = tp.Tracer(TracerName, trace.WithInstrumentationVersion(version))
+		return nil
+	}
+}
+
+// WithPropagator configures the propagator injecting the trace context into requests when tracing is
+// enabled with WithTracerProvider. Defaults to the global TextMapPropagator.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(o *clientOptions) error {
+		o.propagator = p
+		return nil
+	}
+}
+
+// startRequestSpan starts a span for the given request if tracing is enabled, returning the request to send
+// with the span on its context and trace context headers set. The returned function ends the span.
+func (c client) startRequestSpan(req *http.Request) (*http.Request, func(*http.Response, error)) {
+	if c.tracer == nil {
+		return req, func(*http.Response, error) {}
+	}
+
+	attributes := []attribute.KeyValue{
+		AttributeHTTPMethod.String(req.Method),
+		AttributeURL.String(req.URL.Redacted()),
+		AttributeServerAddress.String(req.URL.Hostname()),
+	}
+
+	if attempt := retry.AttemptFromContext(req.Context()); attempt > 0 {
+		attributes = append(attributes, AttributeHTTPResendCount.Int(attempt))
+	}
+
+	ctx, span := c.tracer.Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	req = req.WithContext(ctx)
+
+	propagator := c.propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return req, func(res *http.Response, err error) {
+		if res != nil {
+			span.SetAttributes(AttributeHTTPStatusCode.Int(res.StatusCode))
+
+			if res.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		span.End()
+	}
+}
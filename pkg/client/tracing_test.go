@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.anx.io/go-anxcloud/pkg/utils/retry"
+)
+
+var _ = Describe("tracing", func() {
+	var (
+		srv      *ghttp.Server
+		recorder *tracetest.SpanRecorder
+		c        Client
+	)
+
+	BeforeEach(func() {
+		srv = ghttp.NewServer()
+		DeferCleanup(srv.Close)
+
+		recorder = tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		var err error
+		c, err = New(
+			BaseURL(srv.URL()),
+			IgnoreMissingToken(),
+			ParseEngineErrors(false),
+			WithTracerProvider(tp),
+			WithPropagator(propagation.TraceContext{}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	attributesOf := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		ret := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			ret[kv.Key] = kv.Value
+		}
+		return ret
+	}
+
+	It("creates a client span per request and propagates the trace context", func() {
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/foo"),
+			func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("traceparent")).NotTo(BeEmpty())
+			},
+			ghttp.RespondWith(200, "{}"),
+		))
+
+		req, err := http.NewRequest("GET", srv.URL()+"/foo", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := c.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Body.Close()).To(Succeed())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("GET"))
+		Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(spans[0].Status().Code).NotTo(Equal(codes.Error))
+
+		attributes := attributesOf(spans[0])
+		Expect(attributes[AttributeHTTPMethod].AsString()).To(Equal("GET"))
+		Expect(attributes[AttributeHTTPStatusCode].AsInt64()).To(BeEquivalentTo(200))
+		Expect(attributes[AttributeURL].AsString()).To(Equal(srv.URL() + "/foo"))
+		Expect(attributes).NotTo(HaveKey(AttributeHTTPResendCount))
+	})
+
+	It("marks spans of failed requests as errors and records retries", func() {
+		srv.AppendHandlers(ghttp.RespondWith(500, "{}"))
+
+		err := retry.RetryWithContext(context.TODO(), 2, 0, func(ctx context.Context) (bool, error) {
+			if retry.AttemptFromContext(ctx) == 0 {
+				return true, context.DeadlineExceeded
+			}
+
+			req, err := http.NewRequestWithContext(ctx, "GET", srv.URL()+"/foo", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := c.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			return false, res.Body.Close()
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+
+		attributes := attributesOf(spans[0])
+		Expect(attributes[AttributeHTTPStatusCode].AsInt64()).To(BeEquivalentTo(500))
+		Expect(attributes[AttributeHTTPResendCount].AsInt64()).To(BeEquivalentTo(1))
+	})
+
+	It("does not create spans or inject headers without a TracerProvider", func() {
+		plain, err := New(BaseURL(srv.URL()), IgnoreMissingToken())
+		Expect(err).NotTo(HaveOccurred())
+
+		srv.AppendHandlers(ghttp.CombineHandlers(
+			func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("traceparent")).To(BeEmpty())
+			},
+			ghttp.RespondWith(200, "{}"),
+		))
+
+		req, err := http.NewRequest("GET", srv.URL()+"/foo", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := plain.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Body.Close()).To(Succeed())
+
+		Expect(recorder.Ended()).To(BeEmpty())
+	})
+})
@@ -25,7 +25,8 @@ type Difference struct {
 	B   interface{}
 }
 
-// Compare compares two objects by the given (dot-nested) attribute names.
+// Compare compares two objects by the given (dot-nested) attribute names. Objects have generated sets of their
+// field names usable as attribute names, e.g. lbaasv1.BackendFields.Name.
 func Compare(a, b interface{}, attributes ...string) ([]Difference, error) {
 	typeA := reflect.TypeOf(a)
 	typeB := reflect.TypeOf(b)
@@ -8,8 +8,29 @@ import (
 	"github.com/go-logr/logr"
 )
 
+type attemptContextKey struct{}
+
+// AttemptFromContext returns the number of the current retry, 0 for the first attempt or
+// when not called within RetryWithContext.
+func AttemptFromContext(ctx context.Context) int {
+	if ctx == nil {
+		return 0
+	}
+
+	attempt, _ := ctx.Value(attemptContextKey{}).(int)
+	return attempt
+}
+
 // Retry helper to ease with retrying tasks using the passed callback function.
 func Retry(ctx context.Context, count int, sleep time.Duration, cb func() (bool, error)) error {
+	return RetryWithContext(ctx, count, sleep, func(context.Context) (bool, error) {
+		return cb()
+	})
+}
+
+// RetryWithContext is like Retry, but passes a context to the callback which carries the
+// number of the current retry, retrievable with AttemptFromContext.
+func RetryWithContext(ctx context.Context, count int, sleep time.Duration, cb func(context.Context) (bool, error)) error {
 	var (
 		err       error
 		retryable bool
@@ -21,7 +42,12 @@ func Retry(ctx context.Context, count int, sleep time.Duration, cb func() (bool,
 			time.Sleep(sleep)
 		}
 
-		if retryable, err = cb(); err == nil {
+		attemptCtx := ctx
+		if ctx != nil {
+			attemptCtx = context.WithValue(ctx, attemptContextKey{}, i)
+		}
+
+		if retryable, err = cb(attemptCtx); err == nil {
 			return nil
 		} else if !retryable {
 			break
@@ -0,0 +1,243 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/fakeengine"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+// ContractObject is implemented by pointers to Objects, used by ContractTests to create new instances.
+type ContractObject[T any] interface {
+	*T
+	types.Object
+}
+
+// ContractOption configures ContractTests for Objects needing special handling, passed with the `contract` spec
+// of the magic comment.
+type ContractOption func(*contractConfig)
+
+type contractConfig struct {
+	prepare []func(o types.Object, op types.Operation)
+	handler func(engine http.Handler) http.Handler
+}
+
+// ContractPrepare adds a function changing the generated data of the Object before it is created or updated, for
+// Objects with constraints not expressible with `validate` struct tags.
+func ContractPrepare(prepare func(o types.Object, op types.Operation)) ContractOption {
+	return func(c *contractConfig) {
+		c.prepare = append(c.prepare, prepare)
+	}
+}
+
+// ContractHandler wraps the handler of the fake Engine, for Objects the Engine responds to in a format deviating
+// from the usual one.
+func ContractHandler(wrap func(engine http.Handler) http.Handler) ContractOption {
+	return func(c *contractConfig) {
+		c.handler = wrap
+	}
+}
+
+// ContractTests contains the logic to test an Object can be created, retrieved, listed, updated and destroyed
+// through the generic API, using an in-memory fake Engine serving the endpoints derived from the Object. The
+// Object is filled with generated data, valid for the `validate` struct tags of the Object when possible.
+//
+// Retrieving, updating and destroying is only tested when the Object supports it. The tests fail for Objects the
+// fake Engine does not support and for generated data rejected by the Object, Objects needing special handling can
+// pass options with the `contract` spec of the magic comment or have to be excluded with the `nocontract` spec.
+func ContractTests[T any, PT ContractObject[T]](_ PT, opts ...ContractOption) {
+	config := contractConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	ginkgo.Context("with the fake engine", func() {
+		var engine *fakeengine.Engine
+		var server *httptest.Server
+		var a api.API
+
+		var o PT
+
+		ginkgo.BeforeEach(func() {
+			o = PT(new(T))
+			MutateObject(o, 1)
+			setIdentifier(o, "")
+			prepareContractObject(o, types.OperationCreate, config)
+
+			// the generated data is used to derive the endpoints, as they might depend on fields of the object
+			engine = fakeengine.New()
+			gomega.Expect(engine.Register(o)).To(gomega.Succeed())
+
+			var handler http.Handler = engine
+			if config.handler != nil {
+				handler = config.handler(engine)
+			}
+
+			server = httptest.NewServer(handler)
+			ginkgo.DeferCleanup(server.Close)
+
+			var err error
+			a, err = api.NewAPI(api.WithClientOptions(
+				client.BaseURL(server.URL),
+				client.IgnoreMissingToken(),
+			))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("creates, retrieves, lists, updates and destroys the object", func() {
+			ctx := context.TODO()
+
+			gomega.Expect(a.Create(ctx, o)).To(gomega.Succeed())
+
+			identifier, err := o.GetIdentifier(ctx)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(identifier).NotTo(gomega.BeEmpty())
+			gomega.Expect(engine.Objects(o)).To(gomega.ConsistOf(identifier))
+
+			retrieved := PT(new(T))
+			setIdentifier(retrieved, identifier)
+
+			if supportsOperation(o, types.OperationGet) {
+				gomega.Expect(a.Get(ctx, retrieved)).To(gomega.Succeed())
+				gomega.Expect(retrieved.GetIdentifier(ctx)).To(gomega.Equal(identifier))
+			}
+
+			// listing uses the created object, as the endpoint might depend on its fields
+			var oc types.ObjectChannel
+			gomega.Expect(a.List(ctx, o, api.ObjectChannel(&oc))).To(gomega.Succeed())
+
+			listed := make([]string, 0, 1)
+			for retriever := range oc {
+				l := PT(new(T))
+				gomega.Expect(retriever(l)).To(gomega.Succeed())
+
+				id, err := l.GetIdentifier(ctx)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				listed = append(listed, id)
+			}
+			gomega.Expect(listed).To(gomega.ConsistOf(identifier))
+
+			if supportsOperation(o, types.OperationUpdate) {
+				MutateObject(o, 2)
+				setIdentifier(o, identifier)
+				prepareContractObject(o, types.OperationUpdate, config)
+
+				gomega.Expect(a.Update(ctx, o)).To(gomega.Succeed())
+				gomega.Expect(o.GetIdentifier(ctx)).To(gomega.Equal(identifier))
+			}
+
+			if supportsOperation(o, types.OperationDestroy) {
+				gomega.Expect(a.Destroy(ctx, o)).To(gomega.Succeed())
+				gomega.Expect(engine.Objects(o)).To(gomega.BeEmpty())
+
+				if supportsOperation(o, types.OperationGet) {
+					err = a.Get(ctx, retrieved)
+					gomega.Expect(err).To(gomega.HaveOccurred())
+					gomega.Expect(api.IgnoreNotFound(err)).To(gomega.Succeed())
+				}
+			}
+		})
+	})
+}
+
+// prepareContractObject changes the generated data of the Object to be valid for the given operation.
+func prepareContractObject(o types.Object, op types.Operation, config contractConfig) {
+	for _, prepare := range config.prepare {
+		prepare(o, op)
+	}
+
+	gomega.Expect(makeValid(o, op)).To(gomega.Succeed())
+}
+
+// supportsOperation returns if the Object supports the given operation, according to its EndpointURL.
+func supportsOperation(o types.Object, op types.Operation) bool {
+	ctx := types.ContextWithOperation(context.TODO(), op)
+	_, err := o.EndpointURL(ctx)
+
+	return !errors.Is(err, api.ErrOperationNotSupported)
+}
+
+// setIdentifier sets the field tagged `anxcloud:"identifier"` of the given Object, including fields of embedded
+// structs.
+func setIdentifier(o interface{}, identifier string) {
+	if f, ok := types.IdentifierField(reflect.ValueOf(o).Elem()); ok && f.Kind() == reflect.String {
+		f.SetString(identifier)
+	}
+}
+
+// makeValid changes the fields of the given Object to satisfy the rules of their `validate` struct tags, as far
+// as possible for generated data, returning the validation error if the Object is still invalid for the given
+// operation afterwards.
+func makeValid(o interface{}, op types.Operation) error {
+	v := reflect.ValueOf(o).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		tag, ok := v.Type().Field(i).Tag.Lookup("validate")
+		if !ok || !v.Field(i).CanSet() {
+			continue
+		}
+
+		for _, rule := range strings.Split(tag, ",") {
+			key, value, _ := strings.Cut(rule, "=")
+			makeValidForRule(v.Field(i), key, value)
+
+			if key == "pattern" {
+				break
+			}
+		}
+	}
+
+	if hook, ok := o.(types.ValidationHook); ok {
+		return hook.Validate(context.TODO(), op)
+	}
+
+	return nil
+}
+
+func makeValidForRule(v reflect.Value, key, value string) {
+	switch v.Kind() {
+	case reflect.String:
+		switch key {
+		case "enum":
+			v.SetString(strings.Split(value, "|")[0])
+		case "cidr":
+			v.SetString("10.0.0.0/8")
+		case "max":
+			if max, err := strconv.Atoi(value); err == nil && v.Len() > max {
+				v.SetString(v.String()[:max])
+			}
+		}
+	case reflect.Slice:
+		switch key {
+		case "max":
+			if max, err := strconv.Atoi(value); err == nil && v.Len() > max {
+				v.SetLen(max)
+			}
+		default:
+			for i := 0; i < v.Len(); i++ {
+				makeValidForRule(v.Index(i), key, value)
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			if (key == "min" && v.Int() < n) || (key == "max" && v.Int() > n) {
+				v.SetInt(n)
+			}
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			makeValidForRule(v.Elem(), key, value)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"go.anx.io/go-anxcloud/pkg/utils/object/compare"
+)
+
+// maxMutateDepth limits how deep mutateValue allocates pointers, slices and maps, to stop on recursive types.
+const maxMutateDepth = 4
+
+// DeepCopyObject is implemented by Objects with generated DeepCopy and Equal methods.
+type DeepCopyObject[T any] interface {
+	*T
+	DeepCopy() *T
+	Equal(other *T) bool
+}
+
+// DeepCopyTests contains the logic to test the generated DeepCopy and Equal methods of an Object, checking
+// * if DeepCopy returns an equal object not sharing any memory with the original one
+// * if Equal agrees with reflect.DeepEqual, also when only a single field differs
+// * if both handle nil objects
+func DeepCopyTests[T any, PT DeepCopyObject[T]](PT) {
+	ginkgo.It("handles nil objects", func() {
+		var o PT
+		gomega.Expect(o.DeepCopy()).To(gomega.BeNil())
+		gomega.Expect(o.Equal(nil)).To(gomega.BeTrue())
+		gomega.Expect(o.Equal(new(T))).To(gomega.BeFalse())
+		gomega.Expect(PT(new(T)).Equal(nil)).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("deep copies the object", func() {
+		o := PT(new(T))
+		MutateObject(o, 1)
+
+		expected := PT(new(T))
+		MutateObject(expected, 1)
+
+		c := PT(o.DeepCopy())
+		gomega.Expect(c).To(gomega.Equal(o))
+		gomega.Expect(c.Equal(o)).To(gomega.BeTrue())
+
+		// mutating the copy in place changes the original, too, when they share memory
+		MutateObject(c, 2)
+		gomega.Expect(o).To(gomega.Equal(expected))
+		gomega.Expect(c.Equal(o)).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("compares every field", func() {
+		o := PT(new(T))
+		MutateObject(o, 1)
+
+		v := reflect.ValueOf(o).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+
+			c := PT(o.DeepCopy())
+			mutateValue(reflect.ValueOf(c).Elem().Field(i), rand.New(rand.NewSource(int64(i))), 0) //nolint:gosec // test data only
+
+			gomega.Expect(c.Equal(o)).To(gomega.Equal(reflect.DeepEqual(c, o)),
+				fmt.Sprintf("Equal disagrees with reflect.DeepEqual for field %v", v.Type().Field(i).Name),
+			)
+		}
+	})
+}
+
+// FieldsTests checks if the generated field name set of an Object contains the names of all exported fields
+// of the Object and can be used with compare.Compare.
+func FieldsTests(o interface{}, fields interface{}) {
+	ginkgo.It("has the names of all exported fields in its field name set", func() {
+		objectType := reflect.TypeOf(o)
+		for objectType.Kind() == reflect.Ptr {
+			objectType = objectType.Elem()
+		}
+
+		expected := make([]string, 0, objectType.NumField())
+		for i := 0; i < objectType.NumField(); i++ {
+			if f := objectType.Field(i); f.IsExported() {
+				expected = append(expected, f.Name)
+			}
+		}
+
+		names := make([]string, 0, len(expected))
+		fv := reflect.ValueOf(fields)
+		for i := 0; i < fv.NumField(); i++ {
+			gomega.Expect(fv.Field(i).String()).To(gomega.Equal(fv.Type().Field(i).Name))
+			names = append(names, fv.Field(i).String())
+		}
+
+		gomega.Expect(names).To(gomega.Equal(expected))
+
+		_, err := compare.Compare(o, o, names...)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+}
+
+// MutateObject changes every settable field of the given object, writing through pointers and into elements of
+// slices and maps already set instead of replacing them. Mutating zero objects with the same seed gives equal
+// objects.
+func MutateObject(o interface{}, seed int64) {
+	mutateValue(reflect.ValueOf(o).Elem(), rand.New(rand.NewSource(seed)), 0) //nolint:gosec // test data only
+}
+
+func mutateValue(v reflect.Value, r *rand.Rand, depth int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(!v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(v.Int() + r.Int63n(100) + 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(v.Uint() + uint64(r.Int63n(100)) + 1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(v.Float() + r.Float64() + 1)
+	case reflect.String:
+		v.SetString(v.String() + fmt.Sprintf("%x", r.Int63()))
+	case reflect.Interface:
+		// only empty interfaces can be set to arbitrary values
+		if v.NumMethod() == 0 {
+			v.Set(reflect.ValueOf(fmt.Sprintf("%x", r.Int63())))
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			if depth >= maxMutateDepth {
+				return
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		mutateValue(v.Elem(), r, depth+1)
+	case reflect.Slice:
+		if v.Len() == 0 {
+			if depth >= maxMutateDepth {
+				return
+			}
+			v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		}
+		for i := 0; i < v.Len(); i++ {
+			mutateValue(v.Index(i), r, depth+1)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			mutateValue(v.Index(i), r, depth+1)
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			if depth >= maxMutateDepth {
+				return
+			}
+			if v.IsNil() {
+				v.Set(reflect.MakeMap(v.Type()))
+			}
+			for i := 0; i < 2; i++ {
+				key := reflect.New(v.Type().Key()).Elem()
+				mutateValue(key, r, depth+1)
+				value := reflect.New(v.Type().Elem()).Elem()
+				mutateValue(value, r, depth+1)
+				v.SetMapIndex(key, value)
+			}
+			return
+		}
+		for _, key := range v.MapKeys() {
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(v.MapIndex(key))
+			mutateValue(value, r, depth+1)
+			v.SetMapIndex(key, value)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				mutateValue(f, r, depth+1)
+			}
+		}
+	}
+}
@@ -76,7 +76,7 @@ type Definition struct {
 	// Plaintext password
 	// Example: ('!anx123mySuperStrongPassword123anx!', 'go3ju0la1ro3', …)
 	// USE IT AT YOUR OWN RISK! (or SSH key instead).
-	Password string `json:"password,omitempty"`
+	Password string `json:"password,omitempty" anxcloud:"secret"`
 
 	// Public key (instead of password, only for Linux systems)
 	// Recommended over providing a plaintext password.
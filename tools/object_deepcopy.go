@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// maxGeneratorDepth limits the nesting of types code is generated for, preventing endless recursion for
+// recursive types which are not objects themselves.
+const maxGeneratorDepth = 16
+
+// loadTypes loads the type information of the package the generator processes. The output file is replaced
+// with an empty file, as the code previously generated into it might not compile anymore.
+func (gen *ObjectGenerator) loadTypes() *types.Package {
+	outfile, err := filepath.Abs(gen.outfile)
+	if err != nil {
+		log.Fatalf("Error resolving output file path \"%v\": %v", gen.outfile, err)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+		Overlay: map[string][]byte{
+			outfile: []byte("package " + gen.pkg + "\n"),
+		},
+	}, "./"+gen.in)
+	if err != nil {
+		log.Fatalf("Error loading package \"%v\": %v", gen.in, err)
+	}
+
+	if len(pkgs) < 1 || pkgs[0].Types == nil {
+		log.Fatalf("Path \"%v\" didn't contain any packages", gen.in)
+	}
+
+	// errors are expected when code in the package uses generated methods, the declarations are fine anyway
+	return pkgs[0].Types
+}
+
+// objectMethodsGenerator generates DeepCopy, Equal and the field name sets for objects.
+type objectMethodsGenerator struct {
+	pkg     *types.Package
+	objects map[string]bool
+	imports map[string]bool
+}
+
+func (gen *ObjectGenerator) generateObjectMethods(w *bytes.Buffer) map[string]bool {
+	g := objectMethodsGenerator{
+		pkg:     gen.loadTypes(),
+		objects: make(map[string]bool),
+		imports: make(map[string]bool),
+	}
+
+	for _, td := range gen.types {
+		if td.IsObject {
+			g.objects[td.Name] = true
+		}
+	}
+
+	for _, td := range gen.types {
+		if !td.IsObject {
+			continue
+		}
+
+		obj := g.pkg.Scope().Lookup(td.Name)
+		if obj == nil {
+			log.Fatalf("Object %v not found in package (%v:%v)", td.Name, td.File, td.Line)
+		}
+
+		st, ok := obj.Type().Underlying().(*types.Struct)
+		if !ok {
+			log.Fatalf("Object %v is not a struct (%v:%v)", td.Name, td.File, td.Line)
+		}
+
+		g.writeDeepCopy(w, td.Name, obj.Type())
+		g.writeEqual(w, td.Name, obj.Type())
+		g.writeFields(w, td.Name, st)
+	}
+
+	return g.imports
+}
+
+func (g *objectMethodsGenerator) writeDeepCopy(w *bytes.Buffer, name string, t types.Type) {
+	fmt.Fprintf(w, "\n// DeepCopy returns a deep copy of the %v object. Values stored in interface fields are copied shallowly.\n", name)
+	fmt.Fprintf(w, "func (o *%v) DeepCopy() *%v {\n", name, name)
+	fmt.Fprintf(w, "if o == nil {\nreturn nil\n}\n\n")
+	fmt.Fprintf(w, "out := *o\n")
+	g.deepCopyStruct(w, "out", t.Underlying().(*types.Struct), g.isLocal(t), 0)
+	fmt.Fprintf(w, "\nreturn &out\n}\n")
+}
+
+func (g *objectMethodsGenerator) writeEqual(w *bytes.Buffer, name string, t types.Type) {
+	fmt.Fprintf(w, "\n// Equal returns if the %v object is equal to the given one, comparing all fields deeply.\n", name)
+	fmt.Fprintf(w, "func (o *%v) Equal(other *%v) bool {\n", name, name)
+	fmt.Fprintf(w, "if o == nil || other == nil {\nreturn o == other\n}\n\n")
+	g.equalStruct(w, "o", "other", t.Underlying().(*types.Struct), g.isLocal(t), 0)
+	fmt.Fprintf(w, "\nreturn true\n}\n")
+}
+
+func (g *objectMethodsGenerator) writeFields(w *bytes.Buffer, name string, st *types.Struct) {
+	fields := make([]string, 0, st.NumFields())
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Exported() {
+			fields = append(fields, f.Name())
+		}
+	}
+
+	fmt.Fprintf(w, "\n// %vFields contains the names of the fields of %v objects, usable with compare.Compare and compare.Reconcile.\n", name, name)
+	fmt.Fprintf(w, "var %vFields = struct {\n", name)
+	for _, f := range fields {
+		fmt.Fprintf(w, "%v string\n", f)
+	}
+	fmt.Fprintf(w, "}{\n")
+	for _, f := range fields {
+		fmt.Fprintf(w, "%v: %q,\n", f, f)
+	}
+	fmt.Fprintf(w, "}\n")
+}
+
+// isLocal returns if the given type is declared in the package code is generated for, allowing access to
+// unexported fields.
+func (g *objectMethodsGenerator) isLocal(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return !ok || named.Obj().Pkg() == g.pkg
+}
+
+// isObject returns if the given type is an object of the package code is generated for, which will have the
+// generated methods.
+func (g *objectMethodsGenerator) isObject(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() == g.pkg && g.objects[named.Obj().Name()]
+}
+
+// hasMethod returns if the method set of t contains a method with the given name, taking a single argument of
+// type arg (if not nil) and returning a single value of type result.
+func hasMethod(t types.Type, name string, arg, result types.Type) bool {
+	sel := types.NewMethodSet(t).Lookup(nil, name)
+	if sel == nil {
+		// method sets only contain exported methods when looked up with a nil package
+		return false
+	}
+
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Results().Len() != 1 || !types.Identical(sig.Results().At(0).Type(), result) {
+		return false
+	}
+
+	if arg == nil {
+		return sig.Params().Len() == 0
+	}
+
+	return sig.Params().Len() == 1 && types.Identical(sig.Params().At(0).Type(), arg)
+}
+
+// hasDeepCopy returns if *T has a DeepCopy method returning *T for the given named type T.
+func (g *objectMethodsGenerator) hasDeepCopy(t types.Type) bool {
+	if _, ok := t.(*types.Named); !ok {
+		return false
+	}
+
+	ptr := types.NewPointer(t)
+	return g.isObject(t) || hasMethod(ptr, "DeepCopy", nil, ptr)
+}
+
+// needsDeepCopy returns if values of the given type share memory when assigned.
+func (g *objectMethodsGenerator) needsDeepCopy(t types.Type, depth int) bool {
+	if depth > maxGeneratorDepth {
+		return true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map:
+		return true
+	case *types.Array:
+		return g.needsDeepCopy(u.Elem(), depth+1)
+	case *types.Struct:
+		local := g.isLocal(t)
+		for i := 0; i < u.NumFields(); i++ {
+			if f := u.Field(i); (local || f.Exported()) && g.needsDeepCopy(f.Type(), depth+1) {
+				return true
+			}
+		}
+	}
+
+	// interfaces, channels and functions cannot be copied and are treated like basic types
+	return false
+}
+
+// deepCopy writes statements replacing everything in expr, which holds a shallow copy of a value of type t,
+// sharing memory with the original value with copies.
+func (g *objectMethodsGenerator) deepCopy(w *bytes.Buffer, expr string, t types.Type, depth int) {
+	if depth > maxGeneratorDepth {
+		log.Fatalf("Type %v is nested too deep to generate DeepCopy, make the recursive type an object", t)
+	}
+
+	if !g.needsDeepCopy(t, depth) {
+		return
+	}
+
+	if g.hasDeepCopy(t) {
+		fmt.Fprintf(w, "%v = *%v.DeepCopy()\n", expr, expr)
+		return
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		if g.hasDeepCopy(u.Elem()) {
+			fmt.Fprintf(w, "%v = %v.DeepCopy()\n", expr, expr)
+			return
+		}
+
+		v := fmt.Sprintf("v%d", depth)
+		fmt.Fprintf(w, "if %v != nil {\n%v := *%v\n", expr, v, expr)
+		g.deepCopy(w, v, u.Elem(), depth+1)
+		fmt.Fprintf(w, "%v = &%v\n}\n", expr, v)
+
+	case *types.Slice:
+		g.imports["slices"] = true
+		fmt.Fprintf(w, "%v = slices.Clone(%v)\n", expr, expr)
+
+		if g.needsDeepCopy(u.Elem(), depth+1) {
+			i := fmt.Sprintf("i%d", depth)
+			fmt.Fprintf(w, "for %v := range %v {\n", i, expr)
+			g.deepCopy(w, fmt.Sprintf("%v[%v]", expr, i), u.Elem(), depth+1)
+			fmt.Fprintf(w, "}\n")
+		}
+
+	case *types.Map:
+		g.imports["maps"] = true
+		fmt.Fprintf(w, "%v = maps.Clone(%v)\n", expr, expr)
+
+		if g.needsDeepCopy(u.Elem(), depth+1) {
+			k, v := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+			fmt.Fprintf(w, "for %v, %v := range %v {\n", k, v, expr)
+			g.deepCopy(w, v, u.Elem(), depth+1)
+			fmt.Fprintf(w, "%v[%v] = %v\n}\n", expr, k, v)
+		}
+
+	case *types.Array:
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "for %v := range %v {\n", i, expr)
+		g.deepCopy(w, fmt.Sprintf("%v[%v]", expr, i), u.Elem(), depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Struct:
+		g.deepCopyStruct(w, expr, u, g.isLocal(t), depth)
+	}
+}
+
+func (g *objectMethodsGenerator) deepCopyStruct(w *bytes.Buffer, expr string, st *types.Struct, local bool, depth int) {
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); local || f.Exported() {
+			g.deepCopy(w, expr+"."+f.Name(), f.Type(), depth+1)
+		}
+	}
+}
+
+// equal writes statements returning false when a and b, both of type t, are not equal.
+func (g *objectMethodsGenerator) equal(w *bytes.Buffer, a, b string, t types.Type, depth int) {
+	if depth > maxGeneratorDepth {
+		log.Fatalf("Type %v is nested too deep to generate Equal, make the recursive type an object", t)
+	}
+
+	ptr := types.NewPointer(t)
+	if _, named := t.(*types.Named); named && (g.isObject(t) || hasMethod(ptr, "Equal", ptr, types.Typ[types.Bool])) {
+		fmt.Fprintf(w, "if !%v.Equal(&%v) {\nreturn false\n}\n", a, b)
+		return
+	} else if named && hasMethod(t, "Equal", t, types.Typ[types.Bool]) {
+		// e.g. time.Time
+		fmt.Fprintf(w, "if !%v.Equal(%v) {\nreturn false\n}\n", a, b)
+		return
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		fmt.Fprintf(w, "if %v != %v {\nreturn false\n}\n", a, b)
+
+	case *types.Pointer:
+		if _, named := u.Elem().(*types.Named); named && (g.isObject(u.Elem()) || hasMethod(t, "Equal", t, types.Typ[types.Bool])) {
+			fmt.Fprintf(w, "if !%v.Equal(%v) {\nreturn false\n}\n", a, b)
+			return
+		}
+
+		fmt.Fprintf(w, "if (%v == nil) != (%v == nil) {\nreturn false\n}\n", a, b)
+		fmt.Fprintf(w, "if %v != nil {\n", a)
+		g.equal(w, "(*"+a+")", "(*"+b+")", u.Elem(), depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Slice:
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "if (%v == nil) != (%v == nil) || len(%v) != len(%v) {\nreturn false\n}\n", a, b, a, b)
+		fmt.Fprintf(w, "for %v := range %v {\n", i, a)
+		g.equal(w, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), u.Elem(), depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Array:
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "for %v := range %v {\n", i, a)
+		g.equal(w, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), u.Elem(), depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Map:
+		k, va, vb := fmt.Sprintf("k%d", depth), fmt.Sprintf("va%d", depth), fmt.Sprintf("vb%d", depth)
+		fmt.Fprintf(w, "if (%v == nil) != (%v == nil) || len(%v) != len(%v) {\nreturn false\n}\n", a, b, a, b)
+		fmt.Fprintf(w, "for %v, %v := range %v {\n", k, va, a)
+		fmt.Fprintf(w, "%v, ok := %v[%v]\nif !ok {\nreturn false\n}\n", vb, b, k)
+		g.equal(w, va, vb, u.Elem(), depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Struct:
+		if g.isLocal(t) || allFieldsExported(u) {
+			g.equalStruct(w, a, b, u, g.isLocal(t), depth)
+		} else if types.Comparable(t) {
+			fmt.Fprintf(w, "if %v != %v {\nreturn false\n}\n", a, b)
+		} else {
+			g.imports["reflect"] = true
+			fmt.Fprintf(w, "if !reflect.DeepEqual(%v, %v) {\nreturn false\n}\n", a, b)
+		}
+
+	case *types.Signature:
+		// functions can only be compared to nil
+		fmt.Fprintf(w, "if (%v == nil) != (%v == nil) {\nreturn false\n}\n", a, b)
+
+	default:
+		// interfaces and channels
+		g.imports["reflect"] = true
+		fmt.Fprintf(w, "if !reflect.DeepEqual(%v, %v) {\nreturn false\n}\n", a, b)
+	}
+}
+
+func (g *objectMethodsGenerator) equalStruct(w *bytes.Buffer, a, b string, st *types.Struct, local bool, depth int) {
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); local || f.Exported() {
+			g.equal(w, a+"."+f.Name(), b+"."+f.Name(), f.Type(), depth+1)
+		}
+	}
+}
+
+func allFieldsExported(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if !st.Field(i).Exported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeImports writes the import block for the generated runtime code.
+func writeImports(w *bytes.Buffer, imports map[string]bool) {
+	std := make([]string, 0, len(imports))
+	other := make([]string, 0, len(imports))
+
+	for imp := range imports {
+		if strings.Contains(imp, ".") {
+			other = append(other, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(other)
+
+	fmt.Fprintf(w, "\nimport (\n")
+	for _, imp := range std {
+		fmt.Fprintf(w, "\t%q\n", imp)
+	}
+
+	if len(std) > 0 && len(other) > 0 {
+		fmt.Fprintf(w, "\n")
+	}
+
+	for _, imp := range other {
+		fmt.Fprintf(w, "\t%q\n", imp)
+	}
+	fmt.Fprintf(w, ")\n")
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"strings"
 
 	"go/ast"
+	"go/format"
 	"go/parser"
 	"go/token"
 
@@ -22,11 +24,16 @@ import (
 const magicCommentPrefix = "// anxcloud:"
 
 type typeDefinition struct {
-	Name     string   `json:"name"`
-	IsObject bool     `json:"isObject"`
-	Hooks    []string `json:"hooks"`
-	File     string   `json:"file"`
-	Line     int      `json:"line"`
+	Name         string   `json:"name"`
+	IsObject     bool     `json:"isObject"`
+	Hooks        []string `json:"hooks"`
+	ResourceType string   `json:"resourceType,omitempty"`
+	ResourceName bool     `json:"resourceName,omitempty"`
+	Discoverable bool     `json:"discoverable,omitempty"`
+	NoContract   bool     `json:"noContract,omitempty"`
+	Contract     string   `json:"contract,omitempty"`
+	File         string   `json:"file"`
+	Line         int      `json:"line"`
 }
 
 // ObjectGenerator parses a single file or package to produce a single output.
@@ -43,6 +50,9 @@ type ObjectGenerator struct {
 
 	alreadyIdentifiedObjects []string
 	identifiableObjects      []identifiableObject
+
+	alreadyValidatedObjects []string
+	validatedObjects        []validatedObject
 }
 
 func (gen *ObjectGenerator) run() {
@@ -80,6 +90,14 @@ func (gen *ObjectGenerator) run() {
 		gen.parseFile(s)
 	}
 
+	var validated []validatedObject
+	if gen.mode == "validation" {
+		if validated = gen.objectsToValidate(); len(validated) == 0 {
+			gen.removeStaleOutput()
+			return
+		}
+	}
+
 	if len(gen.types) > 0 {
 		out, err := os.OpenFile(gen.outfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 		if err != nil {
@@ -98,8 +116,9 @@ func (gen *ObjectGenerator) run() {
 	case "data":
 		json.NewEncoder(gen.out).Encode(gen.types)
 	case "runtime":
-		gen.writeHeader()
-		gen.generateGetIdentifier()
+		gen.generateRuntimeCode()
+	case "validation":
+		gen.generateValidation(validated)
 	default:
 		log.Printf("Mode %v is not yet implemented", gen.mode)
 	}
@@ -121,10 +140,11 @@ func (gen *ObjectGenerator) parseFile(in string) {
 	gen.parseTypeComments(file, fset, in)
 	gen.findAlreadyIdentifiedObjects(file, fset, in)
 	gen.findIdentifiableObjects(file, fset, in)
+	gen.findValidatedObjects(file, fset, in)
 }
 
 func (gen *ObjectGenerator) writeHeader() {
-	if gen.mode == "tests" || gen.mode == "runtime" {
+	if gen.mode == "tests" {
 		fmt.Fprintf(gen.out, "// Code generated by go.anx.io/go-anxcloud/tools object-generator - DO NOT EDIT!\n\n")
 	}
 
@@ -160,11 +180,35 @@ import (
 )
 `, pkgs[0].ID)
 
-	} else if gen.mode == "runtime" {
-		fmt.Fprint(gen.out, `
-import (
-	"context"
-)`)
+	}
+}
+
+// generateRuntimeCode generates the code for the runtime mode into a buffer first, as the imports depend on
+// the generated code.
+func (gen *ObjectGenerator) generateRuntimeCode() {
+	body := bytes.Buffer{}
+	gen.generateGetIdentifier(&body)
+	gen.generateResourceTypeRegistration(&body)
+	imports := gen.generateObjectMethods(&body)
+
+	imports["context"] = true
+	if gen.hasResourceTypes() {
+		imports["go.anx.io/go-anxcloud/pkg/api/types"] = true
+	}
+
+	code := bytes.Buffer{}
+	fmt.Fprintf(&code, "// Code generated by go.anx.io/go-anxcloud/tools object-generator - DO NOT EDIT!\n\n")
+	fmt.Fprintf(&code, "package %v\n", gen.pkg)
+	writeImports(&code, imports)
+	code.Write(body.Bytes())
+
+	formatted, err := format.Source(code.Bytes())
+	if err != nil {
+		log.Fatalf("Error formatting generated code for \"%v\": %v", gen.outfile, err)
+	}
+
+	if _, err := gen.out.Write(formatted); err != nil {
+		log.Fatalf("Error writing output file \"%v\": %v", gen.outfile, err)
 	}
 }
 
@@ -259,6 +303,9 @@ func (gen *ObjectGenerator) processComment(comment string, decl *ast.GenDecl, fi
 			ts := s.(*ast.TypeSpec)
 
 			_, isObject := specs["object"]
+			_, hasResourceName := specs["resourcename"]
+			_, discoverable := specs["resourcediscovery"]
+			_, noContract := specs["nocontract"]
 
 			var hooks []string
 			if h, ok := specs["hooks"]; ok {
@@ -266,11 +313,16 @@ func (gen *ObjectGenerator) processComment(comment string, decl *ast.GenDecl, fi
 			}
 
 			gen.types = append(gen.types, typeDefinition{
-				Name:     ts.Name.Name,
-				IsObject: isObject,
-				Hooks:    hooks,
-				File:     file,
-				Line:     line,
+				Name:         ts.Name.Name,
+				IsObject:     isObject,
+				Hooks:        hooks,
+				ResourceType: specs["resourcetype"],
+				ResourceName: hasResourceName,
+				Discoverable: discoverable,
+				NoContract:   noContract,
+				Contract:     specs["contract"],
+				File:         file,
+				Line:         line,
 			})
 		}
 	}
@@ -291,6 +343,13 @@ var _ = Describe("Object {{ .Name }}", func() {
 	{{- end }}
 
 	testutils.ObjectTests(&o, ifaces...)
+	testutils.DeepCopyTests(&o)
+	testutils.FieldsTests(&o, apipkg.{{ $.Name }}Fields)
+	{{- if and (not .NoContract) .Contract }}
+	testutils.ContractTests(&o, {{ .Contract }}()...)
+	{{- else if not .NoContract }}
+	testutils.ContractTests(&o)
+	{{- end }}
 })
 {{ end }}`
 
@@ -313,6 +372,8 @@ var _ = Describe("Object {{ .Name }}", func() {
 		err := t.ExecuteTemplate(gen.out, "objectTest", map[string]interface{}{
 			"Name":       td.Name,
 			"Interfaces": interfaces,
+			"NoContract": td.NoContract,
+			"Contract":   td.Contract,
 		})
 		if err != nil {
 			log.Fatalf("Error executing template for object tests: %v", err)
@@ -328,7 +389,7 @@ var _ = func() interface{} {
 	tools["object-generator"] = func() {
 		in := flag.String("in", "", "path to file or package directory to process, if it ends with /..., every directory below the given (and including the given) will be processed, as long as they have *.go file inside")
 		outfile := flag.String("out", "", "output file path, relative to the source path")
-		mode := flag.String("mode", "tests", "code generator mode (tests)")
+		mode := flag.String("mode", "tests", "code generator mode (tests, runtime, validation or data)")
 		flag.Parse()
 
 		sources := make([]string, 0, 1)
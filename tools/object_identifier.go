@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/token"
 	"html/template"
+	"io"
 	"log"
 	"sort"
 	"strings"
@@ -14,7 +15,7 @@ type identifiableObject struct {
 	IdentifyingField string
 }
 
-func (gen *ObjectGenerator) generateGetIdentifier() {
+func (gen *ObjectGenerator) generateGetIdentifier(w io.Writer) {
 	const templates = `
 {{range .IdentifiedObjects }}
 // GetIdentifier returns the primary identifier of a {{.ObjectName}} object
@@ -33,7 +34,7 @@ func (o *{{.ObjectName}}) GetIdentifier(ctx context.Context) (string, error) {
 		objectsToIdentify = append(objectsToIdentify, obj)
 	}
 
-	err := t.Execute(gen.out, map[string]interface{}{
+	err := t.Execute(w, map[string]interface{}{
 		"IdentifiedObjects": objectsToIdentify,
 	})
 	if err != nil {
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"io"
+	"log"
+	"text/template"
+)
+
+type resourceTypeRegistration struct {
+	ObjectName       string
+	IdentifyingField string
+	ResourceType     string
+	Discoverable     bool
+	Argument         string
+}
+
+func (gen *ObjectGenerator) hasResourceTypes() bool {
+	for _, td := range gen.types {
+		if td.IsObject && (td.ResourceType != "" || td.Discoverable) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (gen *ObjectGenerator) generateResourceTypeRegistration(w io.Writer) {
+	if !gen.hasResourceTypes() {
+		return
+	}
+
+	const templates = `
+func init() {
+{{- range . }}
+{{- if .Discoverable }}
+	types.RegisterDiscoverableResource(func(identifier, name string) types.Object {
+{{- else }}
+	types.RegisterResourceType("{{ .ResourceType }}", func(identifier, name string) types.Object {
+{{- end }}
+		return &{{ .ObjectName }}{ {{- .IdentifyingField }}: {{ .Argument }}}
+	})
+{{- end }}
+}
+`
+
+	t := template.Must(template.New("").Parse(templates))
+
+	registrations := make([]resourceTypeRegistration, 0)
+	for _, td := range gen.types {
+		if !td.IsObject || (td.ResourceType == "" && !td.Discoverable) {
+			continue
+		} else if td.ResourceType != "" && td.Discoverable {
+			log.Fatalf("Object %v has both a resourcetype and resourcediscovery (%v:%v)", td.Name, td.File, td.Line)
+		}
+
+		field := ""
+		for _, obj := range gen.identifiableObjects {
+			if obj.ObjectName == td.Name {
+				field = obj.IdentifyingField
+			}
+		}
+
+		if field == "" {
+			log.Fatalf("Object %v has a resourcetype or resourcediscovery but no field tagged with anxcloud:\"identifier\" (%v:%v)", td.Name, td.File, td.Line)
+		}
+
+		argument := "identifier"
+		if td.ResourceName {
+			argument = "name"
+		}
+
+		registrations = append(registrations, resourceTypeRegistration{
+			ObjectName:       td.Name,
+			IdentifyingField: field,
+			ResourceType:     td.ResourceType,
+			Discoverable:     td.Discoverable,
+			Argument:         argument,
+		})
+	}
+
+	if err := t.Execute(w, registrations); err != nil {
+		log.Fatalf("Error executing template for resource type registration: %v", err)
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type validatedField struct {
+	Name  string
+	Scope string
+	Chain string
+	Err   string
+}
+
+type validatedObject struct {
+	ObjectName string
+	Fields     []validatedField
+}
+
+// parseValidateTag parses the rules of a `validate` tag into the scope (create, update or empty for both), the
+// chain of FieldValidator method calls and the name of the error to wrap.
+func parseValidateTag(tag, object, field string) (scope, chain, errName string) {
+	rules := strings.Split(tag, ",")
+	calls := make([]string, 0, len(rules))
+
+	for i, rule := range rules {
+		key, value, hasValue := strings.Cut(rule, "=")
+
+		switch key {
+		case "create", "update":
+			if scope != "" {
+				log.Fatalf("Field %v.%v has multiple operation scopes in its validate tag", object, field)
+			}
+			scope = key
+		case "required":
+			calls = append(calls, ".Required()")
+		case "cidr":
+			calls = append(calls, ".CIDR()")
+		case "hostname":
+			calls = append(calls, ".Hostname()")
+		case "lowercase":
+			calls = append(calls, ".Lowercase()")
+		case "enum":
+			values := strings.Split(value, "|")
+			quoted := make([]string, 0, len(values))
+			for _, v := range values {
+				quoted = append(quoted, strconv.Quote(v))
+			}
+			calls = append(calls, fmt.Sprintf(".Enum(%v)", strings.Join(quoted, ", ")))
+		case "min", "max":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				log.Fatalf("Field %v.%v has an invalid %v rule in its validate tag: %v", object, field, key, err)
+			}
+			calls = append(calls, fmt.Sprintf(".%v%v(%v)", strings.ToUpper(key[:1]), key[1:], value))
+		case "err":
+			if !token.IsIdentifier(value) {
+				log.Fatalf("Field %v.%v has an invalid err rule in its validate tag: %q is not an identifier", object, field, value)
+			}
+			errName = value
+		case "pattern":
+			// patterns may contain commas, so they take the rest of the tag
+			value = strings.TrimPrefix(strings.Join(rules[i:], ","), "pattern=")
+			if _, err := regexp.Compile(value); err != nil {
+				log.Fatalf("Field %v.%v has an invalid pattern in its validate tag: %v", object, field, err)
+			}
+			calls = append(calls, fmt.Sprintf(".Pattern(%v)", strconv.Quote(value)))
+			return scope, strings.Join(calls, ""), errName
+		default:
+			log.Fatalf("Field %v.%v has an unknown rule %q in its validate tag", object, field, key)
+		}
+
+		if hasValue && key != "enum" && key != "min" && key != "max" && key != "err" {
+			log.Fatalf("Field %v.%v has a value for rule %q in its validate tag, which doesn't take one", object, field, key)
+		}
+	}
+
+	return scope, strings.Join(calls, ""), errName
+}
+
+func (gen *ObjectGenerator) findValidatedObjects(file *ast.File, fset *token.FileSet, _ string) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncDecl); ok {
+			if fn.Name.String() == "Validate" && fn.Recv != nil && len(fn.Recv.List) == 1 {
+				if r, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+					gen.alreadyValidatedObjects = append(gen.alreadyValidatedObjects, r.X.(*ast.Ident).Name)
+				}
+			}
+		}
+
+		genDecl, ok := n.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			return true
+		}
+
+		for _, s := range genDecl.Specs {
+			spec, ok := s.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+
+			t, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			obj := validatedObject{ObjectName: spec.Name.String()}
+
+			for _, field := range t.Fields.List {
+				if field.Tag == nil || len(field.Names) == 0 {
+					continue
+				}
+
+				tagValue, err := strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					log.Fatalf("Error parsing tag of field %v.%v (%v): %v", obj.ObjectName, field.Names[0], fset.Position(field.Pos()), err)
+				}
+
+				tag, ok := reflect.StructTag(tagValue).Lookup("validate")
+				if !ok {
+					continue
+				}
+
+				for _, name := range field.Names {
+					scope, chain, errName := parseValidateTag(tag, obj.ObjectName, name.String())
+					obj.Fields = append(obj.Fields, validatedField{
+						Name:  name.String(),
+						Scope: scope,
+						Chain: chain,
+						Err:   errName,
+					})
+				}
+			}
+
+			if len(obj.Fields) > 0 {
+				gen.validatedObjects = append(gen.validatedObjects, obj)
+			}
+		}
+
+		return true
+	})
+}
+
+// objectsToValidate returns the objects with validated fields not having a Validate method already.
+func (gen *ObjectGenerator) objectsToValidate() []validatedObject {
+	ret := make([]validatedObject, 0, len(gen.validatedObjects))
+
+	for _, obj := range gen.validatedObjects {
+		isObject := false
+		for _, td := range gen.types {
+			if td.IsObject && td.Name == obj.ObjectName {
+				isObject = true
+			}
+		}
+
+		if !isObject {
+			log.Printf("Type %v has validate tags but is not an object, no Validate method generated", obj.ObjectName)
+			continue
+		}
+
+		if containsUnsorted(gen.alreadyValidatedObjects, obj.ObjectName) {
+			log.Printf("Object %v already has a Validate method, no Validate method generated", obj.ObjectName)
+			continue
+		}
+
+		ret = append(ret, obj)
+	}
+
+	return ret
+}
+
+func (gen *ObjectGenerator) generateValidation(objects []validatedObject) {
+	code := bytes.Buffer{}
+	fmt.Fprintf(&code, "// Code generated by go.anx.io/go-anxcloud/tools object-generator - DO NOT EDIT!\n\n")
+	fmt.Fprintf(&code, "package %v\n", gen.pkg)
+	writeImports(&code, map[string]bool{
+		"context":                                  true,
+		"go.anx.io/go-anxcloud/pkg/api/types":      true,
+		"go.anx.io/go-anxcloud/pkg/api/validation": true,
+	})
+
+	scopes := []struct {
+		scope     string
+		condition string
+	}{
+		{"create", "op == types.OperationCreate"},
+		{"update", "op == types.OperationUpdate"},
+		{"", "op == types.OperationCreate || op == types.OperationUpdate"},
+	}
+
+	for _, obj := range objects {
+		fmt.Fprintf(&code, "\n// Validate checks the %v object before it is sent to the engine with the given operation, returning a\n", obj.ObjectName)
+		fmt.Fprintf(&code, "// *validation.Error describing all invalid fields.\n")
+		fmt.Fprintf(&code, "func (o *%v) Validate(ctx context.Context, op types.Operation) error {\n", obj.ObjectName)
+		fmt.Fprintf(&code, "v := validation.New(%q, op)\n", obj.ObjectName)
+
+		for _, s := range scopes {
+			fields := make([]validatedField, 0, len(obj.Fields))
+			for _, f := range obj.Fields {
+				if f.Scope == s.scope {
+					fields = append(fields, f)
+				}
+			}
+
+			if len(fields) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(&code, "\nif %v {\n", s.condition)
+			for _, f := range fields {
+				errArg := ""
+				if f.Err != "" {
+					errArg = ", " + f.Err
+				}
+
+				fmt.Fprintf(&code, "v.Field(%q, o.%v%v)%v\n", f.Name, f.Name, errArg, f.Chain)
+			}
+			fmt.Fprintf(&code, "}\n")
+		}
+
+		fmt.Fprintf(&code, "\nreturn v.Err()\n}\n")
+	}
+
+	formatted, err := format.Source(code.Bytes())
+	if err != nil {
+		log.Fatalf("Error formatting generated code for \"%v\": %v", gen.outfile, err)
+	}
+
+	if _, err := gen.out.Write(formatted); err != nil {
+		log.Fatalf("Error writing output file \"%v\": %v", gen.outfile, err)
+	}
+}
+
+// removeStaleOutput removes the output file when there is nothing to generate into it anymore.
+func (gen *ObjectGenerator) removeStaleOutput() {
+	if err := os.Remove(gen.outfile); err != nil && !os.IsNotExist(err) {
+		log.Printf("Error removing stale output file \"%v\": %v", gen.outfile, err)
+	}
+}
+
+func containsUnsorted(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+
+	return false
+}